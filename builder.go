@@ -1,6 +1,13 @@
 package sequel
 
 type QueryBuilder interface {
+	// With
+	With(name string, fn func(QueryBuilder)) QueryBuilder
+	WithColumns(name string, columns []string, fn func(QueryBuilder)) QueryBuilder
+	WithRecursive(name string, columns []string, fn func(QueryBuilder)) QueryBuilder
+	WithMaterialized(name string, fn func(QueryBuilder)) QueryBuilder
+	WithNotMaterialized(name string, fn func(QueryBuilder)) QueryBuilder
+
 	// Select
 	Select(columns ...string) QueryBuilder
 	SelectRaw(expr string, args ...any) QueryBuilder
@@ -12,12 +19,50 @@ type QueryBuilder interface {
 	AddSelectSafe(userInput []string, whitelist map[string]string) QueryBuilder
 	AddSelectSub(fn func(QueryBuilder), alias string) QueryBuilder
 
+	Distinct() QueryBuilder
+	DistinctOn(columns ...string) QueryBuilder
+
 	// From
 	From(table string) QueryBuilder
 	FromRaw(expr string, args ...any) QueryBuilder
 	FromSafe(userInput string, whitelist map[string]string) QueryBuilder
 	FromSub(fn func(QueryBuilder), alias string) QueryBuilder
+	Final() QueryBuilder
+	Sample(ratio float64) QueryBuilder
 	ToSQL() (string, []any, error)
+	ToCountSQL() (string, []any, error)
+
+	// Insert
+	Insert(table string) QueryBuilder
+	Columns(columns ...string) QueryBuilder
+	Values(values ...any) QueryBuilder
+	ValuesMap(rows ...map[string]any) QueryBuilder
+
+	// Update
+	Update(table string) QueryBuilder
+	Set(column string, value any) QueryBuilder
+	SetMap(values map[string]any) QueryBuilder
+	SetRaw(expr string, args ...any) QueryBuilder
+	UpdateFrom(table string) QueryBuilder
+	AllowFullTable() QueryBuilder
+
+	// Delete
+	Delete() QueryBuilder
+	DeleteFrom(table string) QueryBuilder
+	Using(table string) QueryBuilder
+
+	// Returning
+	Returning(columns ...string) QueryBuilder
+	ReturningRaw(expr string, args ...any) QueryBuilder
+
+	// Upsert
+	OnConflict(columns ...string) QueryBuilder
+	OnConflictConstraint(name string) QueryBuilder
+	DoNothing() QueryBuilder
+	DoUpdateSet(column string, value any) QueryBuilder
+	DoUpdateSetRaw(expr string, args ...any) QueryBuilder
+	DoUpdateSetExcluded(columns ...string) QueryBuilder
+	DoUpdateWhere(fn func(QueryBuilder)) QueryBuilder
 
 	// Where
 	Where(column string, operator string, values ...any) QueryBuilder
@@ -52,35 +97,104 @@ type QueryBuilder interface {
 	WhereNotExists(sub func(QueryBuilder)) QueryBuilder
 	OrWhereNotExists(sub func(QueryBuilder)) QueryBuilder
 
+	// PreWhere
+	PreWhere(column string, operator string, values ...any) QueryBuilder
+	OrPreWhere(column string, operator string, values ...any) QueryBuilder
+
+	PreWhereBetween(column string, from, to any) QueryBuilder
+	OrPreWhereBetween(column string, from, to any) QueryBuilder
+
+	PreWhereIn(column string, values ...any) QueryBuilder
+	OrPreWhereIn(column string, values ...any) QueryBuilder
+
+	PreWhereRaw(expr string, args ...any) QueryBuilder
+	OrPreWhereRaw(expr string, args ...any) QueryBuilder
+
+	PreWhereGroup(fn func(QueryBuilder)) QueryBuilder
+	OrPreWhereGroup(fn func(QueryBuilder)) QueryBuilder
+
 	// Joins
 	Join(table, leftCol, operator, rightCol string) QueryBuilder
 	LeftJoin(table, leftCol, operator, rightCol string) QueryBuilder
 	RightJoin(table, leftCol, operator, rightCol string) QueryBuilder
+	JoinOn(table string, fn func(JoinClause)) QueryBuilder
+	LeftJoinOn(table string, fn func(JoinClause)) QueryBuilder
+	RightJoinOn(table string, fn func(JoinClause)) QueryBuilder
+	FullJoin(table, leftCol, operator, rightCol string) QueryBuilder
+	CrossJoin(table string) QueryBuilder
+	NaturalJoin(table string) QueryBuilder
+	JoinUsing(table string, columns ...string) QueryBuilder
+	JoinLateral(fn func(QueryBuilder), alias string) QueryBuilder
+	LeftJoinLateral(fn func(QueryBuilder), alias string) QueryBuilder
+	JoinSub(fn func(QueryBuilder), alias, leftCol, operator, rightCol string) QueryBuilder
+	LeftJoinSub(fn func(QueryBuilder), alias, leftCol, operator, rightCol string) QueryBuilder
+	JoinRaw(expr string, args ...any) QueryBuilder
+	ArrayJoin(columns ...string) QueryBuilder
+	LeftArrayJoin(columns ...string) QueryBuilder
+
+	// Group By
+	GroupBy(columns ...string) QueryBuilder
+	GroupByRaw(expr string, args ...any) QueryBuilder
+
+	// Having
+	Having(column string, operator string, values ...any) QueryBuilder
+	OrHaving(column string, operator string, values ...any) QueryBuilder
+
+	HavingBetween(column string, from, to any) QueryBuilder
+	OrHavingBetween(column string, from, to any) QueryBuilder
+
+	HavingIn(column string, values ...any) QueryBuilder
+	OrHavingIn(column string, values ...any) QueryBuilder
+
+	HavingRaw(expr string, args ...any) QueryBuilder
+	OrHavingRaw(expr string, args ...any) QueryBuilder
+
+	HavingGroup(fn func(QueryBuilder)) QueryBuilder
+	OrHavingGroup(fn func(QueryBuilder)) QueryBuilder
 
 	// Order By
 	OrderBy(column, direction string) QueryBuilder
 	OrderByRaw(expr string, args ...any) QueryBuilder
 	OrderBySafe(userInput, dir string, whitelist map[string]string) QueryBuilder
 
+	// Combine
+	Union(fn func(QueryBuilder)) QueryBuilder
+	UnionAll(fn func(QueryBuilder)) QueryBuilder
+	Intersect(fn func(QueryBuilder)) QueryBuilder
+	Except(fn func(QueryBuilder)) QueryBuilder
+
 	// Pagination
 	Limit(limit int) QueryBuilder
 	Offset(offset int) QueryBuilder
+	LimitBy(limit int, columns ...string) QueryBuilder
 
+	Clone() QueryBuilder
 	Dialect() Dialect
 }
 
 type QueryType uint8
 
 const (
-	QueryBasic   QueryType = 1
-	QueryBetween QueryType = 2
-	QueryIn      QueryType = 3
-	QueryNested  QueryType = 4
-	QueryNull    QueryType = 5
-	QueryRaw     QueryType = 6
-	QuerySub     QueryType = 7
+	QueryBasic    QueryType = 1
+	QueryBetween  QueryType = 2
+	QueryIn       QueryType = 3
+	QueryNested   QueryType = 4
+	QueryNull     QueryType = 5
+	QueryRaw      QueryType = 6
+	QuerySub      QueryType = 7
+	QueryExcluded QueryType = 8
+	QueryColumn   QueryType = 9
+	QueryExpr     QueryType = 10
 )
 
+type cte struct {
+	name         string
+	columns      []string
+	recursive    bool
+	materialized string
+	sub          QueryBuilder
+}
+
 type column struct {
 	queryType QueryType
 	name      string
@@ -105,6 +219,13 @@ type orderBy struct {
 	args      []any
 }
 
+type groupBy struct {
+	queryType QueryType
+	column    string
+	expr      string
+	args      []any
+}
+
 type where struct {
 	queryType QueryType
 	column    string
@@ -116,6 +237,26 @@ type where struct {
 	sub       QueryBuilder
 }
 
+type set struct {
+	queryType QueryType
+	column    string
+	expr      string
+	args      []any
+}
+
+type union struct {
+	operator string
+	sub      QueryBuilder
+}
+
+type onConflict struct {
+	columns    []string
+	constraint string
+	action     string
+	sets       []set
+	wheres     []where
+}
+
 type join struct {
 	queryType QueryType
 	joinType  string
@@ -123,19 +264,54 @@ type join struct {
 	leftCol   string
 	operator  string
 	rightCol  string
+	on        []where
+	using     []string
+	lateral   bool
+	expr      string
+	args      []any
+	sub       QueryBuilder
+}
+
+type arrayJoin struct {
+	joinType string
+	columns  []string
+}
+
+type limitBy struct {
+	limit   int
+	columns []string
 }
 
 type builder struct {
-	dialect  Dialect
-	action   string
-	table    table
-	columns  []column
-	wheres   []where
-	joins    []join
-	orderBys []orderBy
-	limit    int
-	offset   int
-	err      error
+	dialect        Dialect
+	action         string
+	ctes           []cte
+	table          table
+	final          bool
+	sample         float64
+	using          []table
+	distinct       bool
+	distinctOn     []string
+	columns        []column
+	insertColumns  []string
+	values         [][]any
+	sets           []set
+	wheres         []where
+	preWheres      []where
+	joins          []join
+	arrayJoins     []arrayJoin
+	groupBys       []groupBy
+	havings        []where
+	inHaving       bool
+	unions         []union
+	orderBys       []orderBy
+	returning      []column
+	conflict       *onConflict
+	limit          int
+	limitBy        *limitBy
+	offset         int
+	allowFullTable bool
+	err            error
 }
 
 func New(d Dialect) QueryBuilder {
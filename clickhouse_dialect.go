@@ -0,0 +1,135 @@
+package sequel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var clickHouseOperators = comparisonOperators.with(
+	"==", "ILIKE", "NOT ILIKE",
+)
+
+// clickHouseCompoundOperators spells UNION as UNION DISTINCT, a bare UNION
+// is rejected unless union_default_mode is set.
+var clickHouseCompoundOperators = map[string]string{"UNION": "UNION DISTINCT"}
+
+// ClickHouseDialect targets ClickHouse. IdentifierPattern, when set, must match
+// every part of an identifier.
+type ClickHouseDialect struct {
+	IdentifierPattern *regexp.Regexp
+}
+
+func (d ClickHouseDialect) Capabilities() DialectCapabilities {
+	return DialectCapabilities{
+		SupportsArrayJoin:       true,
+		SupportsDistinctOn:      true,
+		SupportsExcept:          true,
+		SupportsFinal:           true,
+		SupportsFullJoin:        true,
+		SupportsIntersect:       true,
+		SupportsJoinUsing:       true,
+		SupportsLateral:         false,
+		SupportsLimitBy:         true,
+		SupportsMaterializedCTE: false,
+		SupportsNaturalJoin:     false,
+		SupportsPreWhere:        true,
+		SupportsReturning:       false,
+		SupportsRightJoin:       true,
+		SupportsSample:          true,
+		SupportsUpsert:          false,
+	}
+}
+
+func (d ClickHouseDialect) Placeholder(n int) string {
+	return "?"
+}
+
+func (d ClickHouseDialect) SupportsOperator(operator string) bool {
+	return clickHouseOperators.has(operator)
+}
+
+func (d ClickHouseDialect) ValidIdentifier(id string) bool {
+	return validIdentifier(id, d.IdentifierPattern)
+}
+
+func (d ClickHouseDialect) WrapColumn(expr string) string {
+	return backticks.column(expr)
+}
+
+func (d ClickHouseDialect) WrapIdentifier(id string) string {
+	return backticks.identifier(id)
+}
+
+func (d ClickHouseDialect) WrapTable(expr string) string {
+	return backticks.table(expr)
+}
+
+func (d ClickHouseDialect) compiler() compiler {
+	return compiler{
+		dialect:           d,
+		selectOnlyCTEs:    true,
+		backslashEscapes:  true,
+		compoundOperators: clickHouseCompoundOperators,
+		requireWhere:      true,
+		distinct:          d.compileDistinctClause,
+		paging:            d.compilePaging,
+		updateTarget:      d.compileUpdateTarget,
+		updateFrom:        compiler.rejectUpdateFrom,
+		deleteFrom:        compiler.rejectDeleteUsing,
+	}
+}
+
+func (d ClickHouseDialect) CompileSelect(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileSelect, b)
+}
+
+func (d ClickHouseDialect) CompileInsert(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileInsert, b)
+}
+
+func (d ClickHouseDialect) CompileUpdate(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileUpdate, b)
+}
+
+func (d ClickHouseDialect) CompileDelete(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileDelete, b)
+}
+
+// compileDistinctClause writes DISTINCT ON, which has no ORDER BY
+// requirement in ClickHouse.
+func (d ClickHouseDialect) compileDistinctClause(c compiler, b *builder) (string, error) {
+	if len(b.distinctOn) == 0 {
+		return "DISTINCT ", nil
+	}
+
+	return "DISTINCT ON (" + c.compileColumnExprList(b.distinctOn) + ") ", nil
+}
+
+// compilePaging writes LIMIT n BY columns and LIMIT / OFFSET.
+func (d ClickHouseDialect) compilePaging(c compiler, b *builder) string {
+	var sb strings.Builder
+
+	if b.limitBy != nil {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d BY ", b.limitBy.limit))
+		sb.WriteString(c.compileColumnExprList(b.limitBy.columns))
+	}
+
+	if b.limit >= 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", b.limit))
+		if b.offset >= 0 {
+			sb.WriteString(fmt.Sprintf(" OFFSET %d", b.offset))
+		}
+	} else if b.offset >= 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d ROWS", b.offset))
+	}
+
+	return sb.String()
+}
+
+// compileUpdateTarget compiles ALTER TABLE ... UPDATE, updates run as
+// mutations. The compiler writes WHERE 1 when there is no filter, as
+// mutations always require a WHERE clause.
+func (d ClickHouseDialect) compileUpdateTarget(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	return "ALTER TABLE " + d.WrapTable(b.table.name) + " UPDATE ", nil
+}
@@ -0,0 +1,869 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClickHouseDialect_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		expectedArrayJoin       bool
+		expectedDistinctOn      bool
+		expectedExcept          bool
+		expectedFinal           bool
+		expectedFullJoin        bool
+		expectedIntersect       bool
+		expectedJoinUsing       bool
+		expectedLateral         bool
+		expectedLimitBy         bool
+		expectedMaterializedCTE bool
+		expectedNaturalJoin     bool
+		expectedPreWhere        bool
+		expectedReturning       bool
+		expectedRightJoin       bool
+		expectedSample          bool
+		expectedUpsert          bool
+	}{
+		{
+			name:                    "should return correct capabilities for ClickHouse",
+			expectedArrayJoin:       true,
+			expectedDistinctOn:      true,
+			expectedExcept:          true,
+			expectedFinal:           true,
+			expectedFullJoin:        true,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         false,
+			expectedLimitBy:         true,
+			expectedMaterializedCTE: false,
+			expectedNaturalJoin:     false,
+			expectedPreWhere:        true,
+			expectedReturning:       false,
+			expectedRightJoin:       true,
+			expectedSample:          true,
+			expectedUpsert:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := ClickHouseDialect{}
+
+			// Act
+			caps := d.Capabilities()
+
+			// Assert
+			assert.Equal(t, tt.expectedArrayJoin, caps.SupportsArrayJoin, "expected SupportsArrayJoin to match")
+			assert.Equal(t, tt.expectedDistinctOn, caps.SupportsDistinctOn, "expected SupportsDistinctOn to match")
+			assert.Equal(t, tt.expectedExcept, caps.SupportsExcept, "expected SupportsExcept to match")
+			assert.Equal(t, tt.expectedFinal, caps.SupportsFinal, "expected SupportsFinal to match")
+			assert.Equal(t, tt.expectedFullJoin, caps.SupportsFullJoin, "expected SupportsFullJoin to match")
+			assert.Equal(t, tt.expectedIntersect, caps.SupportsIntersect, "expected SupportsIntersect to match")
+			assert.Equal(t, tt.expectedJoinUsing, caps.SupportsJoinUsing, "expected SupportsJoinUsing to match")
+			assert.Equal(t, tt.expectedLateral, caps.SupportsLateral, "expected SupportsLateral to match")
+			assert.Equal(t, tt.expectedLimitBy, caps.SupportsLimitBy, "expected SupportsLimitBy to match")
+			assert.Equal(t, tt.expectedMaterializedCTE, caps.SupportsMaterializedCTE, "expected SupportsMaterializedCTE to match")
+			assert.Equal(t, tt.expectedNaturalJoin, caps.SupportsNaturalJoin, "expected SupportsNaturalJoin to match")
+			assert.Equal(t, tt.expectedPreWhere, caps.SupportsPreWhere, "expected SupportsPreWhere to match")
+			assert.Equal(t, tt.expectedReturning, caps.SupportsReturning, "expected SupportsReturning to match")
+			assert.Equal(t, tt.expectedRightJoin, caps.SupportsRightJoin, "expected SupportsRightJoin to match")
+			assert.Equal(t, tt.expectedSample, caps.SupportsSample, "expected SupportsSample to match")
+			assert.Equal(t, tt.expectedUpsert, caps.SupportsUpsert, "expected SupportsUpsert to match")
+		})
+	}
+}
+
+func TestClickHouseDialect_Placeholder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{
+			name:     "should return ? for first placeholder",
+			n:        1,
+			expected: "?",
+		},
+		{
+			name:     "should return ? for tenth placeholder",
+			n:        10,
+			expected: "?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := ClickHouseDialect{}
+
+			// Act
+			result := d.Placeholder(tt.n)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected placeholder to match")
+		})
+	}
+}
+
+func TestClickHouseDialect_SupportsOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept ==",
+			operator: "==",
+			expected: true,
+		},
+		{
+			name:     "should accept ilike",
+			operator: "ilike",
+			expected: true,
+		},
+		{
+			name:     "should reject <=>",
+			operator: "<=>",
+			expected: false,
+		},
+		{
+			name:     "should reject @>",
+			operator: "@>",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := ClickHouseDialect{}
+
+			// Act
+			result := d.SupportsOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
+func TestClickHouseDialect_WrapColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote simple column without alias",
+			input:    "id",
+			expected: "`id`",
+		},
+		{
+			name:     "should quote column with alias using AS",
+			input:    "name AS username",
+			expected: "`name` AS `username`",
+		},
+		{
+			name:     "should quote column with lowercase as",
+			input:    "name as username",
+			expected: "`name` AS `username`",
+		},
+		{
+			name:     "should quote table.column with alias",
+			input:    "users.id AS user_id",
+			expected: "`users`.`id` AS `user_id`",
+		},
+		{
+			name:     "should handle extra spaces before alias",
+			input:    "email     AS    email_address",
+			expected: "`email` AS `email_address`",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := ClickHouseDialect{}
+
+			// Act
+			result := d.WrapColumn(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted column to match")
+		})
+	}
+}
+
+func TestClickHouseDialect_WrapIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote single identifier",
+			input:    "users",
+			expected: "`users`",
+		},
+		{
+			name:     "should quote database and table",
+			input:    "app.users",
+			expected: "`app`.`users`",
+		},
+		{
+			name:     "should quote database, table and column",
+			input:    "app.users.id",
+			expected: "`app`.`users`.`id`",
+		},
+		{
+			name:     "should double embedded quotes",
+			input:    "user`name",
+			expected: "`user``name`",
+		},
+		{
+			name:     "should keep a bare star",
+			input:    "*",
+			expected: "*",
+		},
+		{
+			name:     "should keep a qualified star unquoted",
+			input:    "users.*",
+			expected: "`users`.*",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := ClickHouseDialect{}
+
+			// Act
+			result := d.WrapIdentifier(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted identifier to match")
+		})
+	}
+}
+
+func TestClickHouseDialect_WrapTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote table without alias",
+			input:    "users",
+			expected: "`users`",
+		},
+		{
+			name:     "should quote table with alias",
+			input:    "users u",
+			expected: "`users` AS `u`",
+		},
+		{
+			name:     "should quote database qualified table with alias",
+			input:    "app.users u",
+			expected: "`app`.`users` AS `u`",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := ClickHouseDialect{}
+
+			// Act
+			result := d.WrapTable(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted table to match")
+		})
+	}
+}
+
+func TestClickHouseDialect_Select(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build select all query when columns are empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("events")
+			},
+			expectedSQL:  "SELECT * FROM `events`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build select with table alias and qualified columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("e.id", "e.name AS event_name").
+					From("analytics.events e")
+			},
+			expectedSQL:  "SELECT `e`.`id`, `e`.`name` AS `event_name` FROM `analytics`.`events` AS `e`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should add FINAL after the table",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users u").
+					Final()
+			},
+			expectedSQL:  "SELECT `id` FROM `users` AS `u` FINAL",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should add SAMPLE with a relative ratio",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectRaw("count()").
+					From("hits").
+					Sample(0.1)
+			},
+			expectedSQL:  "SELECT count() FROM `hits` SAMPLE 0.1",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should add FINAL before SAMPLE with a row count",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectRaw("count()").
+					From("hits").
+					Sample(10000).
+					Final()
+			},
+			expectedSQL:  "SELECT count() FROM `hits` FINAL SAMPLE 10000",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error for FINAL on a subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					Final().
+					FromSub(func(q QueryBuilder) {
+						q.Select("id").From("users")
+					}, "u")
+			},
+			expectedError: ErrInvalidTableInput,
+		},
+		{
+			name: "should return error for SAMPLE on a raw table",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectRaw("count()").
+					FromRaw("numbers(10)").
+					Sample(0.1)
+			},
+			expectedError: ErrInvalidTableInput,
+		},
+		{
+			name: "should build ARRAY JOIN and LEFT ARRAY JOIN before regular joins",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("e.id", "tag").
+					From("events e").
+					Join("users u", "u.id", "=", "e.user_id").
+					ArrayJoin("e.tags AS tag").
+					LeftArrayJoin("e.scores")
+			},
+			expectedSQL:  "SELECT `e`.`id`, `tag` FROM `events` AS `e` ARRAY JOIN `e`.`tags` AS `tag` LEFT ARRAY JOIN `e`.`scores` INNER JOIN `users` AS `u` ON `u`.`id` = `e`.`user_id`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build PREWHERE before WHERE with args in order",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("events").
+					Where("status", "=", "ok").
+					PreWhere("event_date", ">=", "2024-01-01").
+					OrPreWhereIn("site_id", 1, 2)
+			},
+			expectedSQL:  "SELECT `id` FROM `events` PREWHERE `event_date` >= ? OR `site_id` IN (?, ?) WHERE `status` = ?",
+			expectedArgs: []any{"2024-01-01", 1, 2, "ok"},
+		},
+		{
+			name: "should build LIMIT BY before LIMIT",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id", "page").
+					From("views").
+					OrderBy("ts", "desc").
+					LimitBy(3, "user_id").
+					Limit(100)
+			},
+			expectedSQL:  "SELECT `user_id`, `page` FROM `views` ORDER BY `ts` DESC LIMIT 3 BY `user_id` LIMIT 100",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build DISTINCT ON without an ORDER BY requirement",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DistinctOn("user_id").
+					Select("user_id", "ts").
+					From("views").
+					OrderBy("ts", "desc")
+			},
+			expectedSQL:  "SELECT DISTINCT ON (`user_id`) `user_id`, `ts` FROM `views` ORDER BY `ts` DESC",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should spell UNION as UNION DISTINCT",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("a").
+					Where("x", "=", 1).
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("b").Where("y", "=", 2)
+					}).
+					UnionAll(func(q QueryBuilder) {
+						q.Select("id").From("c")
+					})
+			},
+			expectedSQL:  "SELECT `id` FROM `a` WHERE `x` = ? UNION DISTINCT (SELECT `id` FROM `b` WHERE `y` = ?) UNION ALL (SELECT `id` FROM `c`)",
+			expectedArgs: []any{1, 2},
+		},
+		{
+			name: "should return error when table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("")
+			},
+			expectedError: ErrEmptyTable,
+		},
+		{
+			name: "should return error for materialized CTE",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					WithMaterialized("recent", func(q QueryBuilder) {
+						q.Select("id").From("events")
+					}).
+					Select("id").
+					From("recent")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: ClickHouseDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestClickHouseDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		table        string
+		limit        int
+		offset       int
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name:         "should build select with limit",
+			table:        "events",
+			limit:        10,
+			offset:       -1, // default
+			expectedSQL:  "SELECT * FROM `events` LIMIT 10",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build OFFSET ROWS when only offset is set",
+			table:        "events",
+			limit:        -1, // default
+			offset:       5,
+			expectedSQL:  "SELECT * FROM `events` OFFSET 5 ROWS",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with limit and offset",
+			table:        "events",
+			limit:        10,
+			offset:       5,
+			expectedSQL:  "SELECT * FROM `events` LIMIT 10 OFFSET 5",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should ignore negative limit and offset",
+			table:        "events",
+			limit:        -10,
+			offset:       -5,
+			expectedSQL:  "SELECT * FROM `events`",
+			expectedArgs: []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: ClickHouseDialect{},
+				action:  "select",
+				table: table{
+					queryType: QueryBasic,
+					name:      tt.table,
+				},
+				limit:  tt.limit,
+				offset: tt.offset,
+			}
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestClickHouseDialect_Insert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build multi-row insert",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("events").
+					Columns("id", "name").
+					Values(1, "click").
+					Values(2, "view")
+			},
+			expectedSQL:  "INSERT INTO `events` (`id`, `name`) VALUES (?, ?), (?, ?)",
+			expectedArgs: []any{1, "click", 2, "view"},
+		},
+		{
+			name: "should return error for CTE on insert",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					With("src", func(q QueryBuilder) {
+						q.Select("id").From("staging")
+					}).
+					Insert("events").
+					Columns("id").
+					Values(1)
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return error when values are missing",
+			build: func(b *builder) QueryBuilder {
+				return b.Insert("events")
+			},
+			expectedError: ErrEmptyValues,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: ClickHouseDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileInsert(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestClickHouseDialect_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build update as an ALTER TABLE mutation",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("name", "John").
+					SetRaw("`visits` = `visits` + ?", 1).
+					Where("id", "=", 1)
+			},
+			expectedSQL:  "ALTER TABLE `users` UPDATE `name` = ?, `visits` = `visits` + ? WHERE `id` = ?",
+			expectedArgs: []any{"John", 1, 1},
+		},
+		{
+			name: "should build full table update with WHERE 1",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false).
+					AllowFullTable()
+			},
+			expectedSQL:  "ALTER TABLE `users` UPDATE `active` = ? WHERE 1",
+			expectedArgs: []any{false},
+		},
+		{
+			name: "should return error for update from",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("orders").
+					UpdateFrom("users").
+					Set("status", "vip").
+					Where("id", "=", 1)
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return error when where is missing",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false)
+			},
+			expectedError: ErrUnfilteredUpdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: ClickHouseDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileUpdate(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestClickHouseDialect_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build delete with where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("events").
+					Where("id", "=", 1)
+			},
+			expectedSQL:  "DELETE FROM `events` WHERE `id` = ?",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build full table delete with WHERE 1",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("events").
+					AllowFullTable()
+			},
+			expectedSQL:  "DELETE FROM `events` WHERE 1",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error for delete using",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("events").
+					Using("users").
+					Where("users.banned", "=", true)
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return error when where is missing",
+			build: func(b *builder) QueryBuilder {
+				return b.DeleteFrom("events")
+			},
+			expectedError: ErrUnfilteredDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: ClickHouseDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileDelete(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkClickHouseDialect_Select(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: ClickHouseDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Select("user_id", "page").
+			From("views").
+			Final().
+			Sample(0.1).
+			PreWhere("event_date", ">=", "2024-01-01").
+			Where("active", "=", true).
+			OrderBy("ts", "desc").
+			LimitBy(3, "user_id").
+			Limit(10)
+
+		_, _, _ = bd.dialect.CompileSelect(bd)
+	}
+}
@@ -0,0 +1,134 @@
+package sequel
+
+import "slices"
+
+// Clone returns a deep copy of the builder so a base query can be branched
+// without the branches sharing clauses. Argument values themselves are not
+// copied.
+func (b *builder) Clone() QueryBuilder {
+	return b.clone()
+}
+
+func (b *builder) clone() *builder {
+	c := *b
+
+	c.ctes = slices.Clone(b.ctes)
+	for i := range c.ctes {
+		c.ctes[i].columns = slices.Clone(c.ctes[i].columns)
+		c.ctes[i].sub = cloneSub(c.ctes[i].sub)
+	}
+
+	c.table = cloneTable(b.table)
+	c.using = slices.Clone(b.using)
+	for i := range c.using {
+		c.using[i] = cloneTable(c.using[i])
+	}
+
+	c.distinctOn = slices.Clone(b.distinctOn)
+	c.columns = cloneColumns(b.columns)
+	c.insertColumns = slices.Clone(b.insertColumns)
+
+	c.values = slices.Clone(b.values)
+	for i := range c.values {
+		c.values[i] = slices.Clone(c.values[i])
+	}
+
+	c.sets = cloneSets(b.sets)
+	c.wheres = cloneWheres(b.wheres)
+	c.preWheres = cloneWheres(b.preWheres)
+
+	c.joins = slices.Clone(b.joins)
+	for i := range c.joins {
+		j := &c.joins[i]
+		j.on = cloneWheres(j.on)
+		j.using = slices.Clone(j.using)
+		j.args = slices.Clone(j.args)
+		j.sub = cloneSub(j.sub)
+	}
+
+	c.arrayJoins = slices.Clone(b.arrayJoins)
+	for i := range c.arrayJoins {
+		c.arrayJoins[i].columns = slices.Clone(c.arrayJoins[i].columns)
+	}
+
+	c.groupBys = slices.Clone(b.groupBys)
+	for i := range c.groupBys {
+		c.groupBys[i].args = slices.Clone(c.groupBys[i].args)
+	}
+
+	c.havings = cloneWheres(b.havings)
+
+	c.unions = slices.Clone(b.unions)
+	for i := range c.unions {
+		c.unions[i].sub = cloneSub(c.unions[i].sub)
+	}
+
+	c.orderBys = slices.Clone(b.orderBys)
+	for i := range c.orderBys {
+		c.orderBys[i].args = slices.Clone(c.orderBys[i].args)
+	}
+
+	c.returning = cloneColumns(b.returning)
+
+	if b.conflict != nil {
+		conflict := *b.conflict
+		conflict.columns = slices.Clone(conflict.columns)
+		conflict.sets = cloneSets(conflict.sets)
+		conflict.wheres = cloneWheres(conflict.wheres)
+		c.conflict = &conflict
+	}
+
+	if b.limitBy != nil {
+		lb := *b.limitBy
+		lb.columns = slices.Clone(lb.columns)
+		c.limitBy = &lb
+	}
+
+	return &c
+}
+
+// cloneSub deep copies a nested builder, leaving other QueryBuilder
+// implementations as they are.
+func cloneSub(q QueryBuilder) QueryBuilder {
+	if sub, ok := q.(*builder); ok && sub != nil {
+		return sub.clone()
+	}
+
+	return q
+}
+
+func cloneTable(t table) table {
+	t.args = slices.Clone(t.args)
+	t.sub = cloneSub(t.sub)
+	return t
+}
+
+func cloneColumns(columns []column) []column {
+	columns = slices.Clone(columns)
+	for i := range columns {
+		columns[i].args = slices.Clone(columns[i].args)
+		columns[i].sub = cloneSub(columns[i].sub)
+	}
+
+	return columns
+}
+
+func cloneSets(sets []set) []set {
+	sets = slices.Clone(sets)
+	for i := range sets {
+		sets[i].args = slices.Clone(sets[i].args)
+	}
+
+	return sets
+}
+
+func cloneWheres(wheres []where) []where {
+	wheres = slices.Clone(wheres)
+	for i := range wheres {
+		wheres[i].args = slices.Clone(wheres[i].args)
+		wheres[i].nested = cloneWheres(wheres[i].nested)
+		wheres[i].sub = cloneSub(wheres[i].sub)
+	}
+
+	return wheres
+}
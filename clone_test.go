@@ -0,0 +1,182 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Clone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		build func(QueryBuilder) QueryBuilder
+	}{
+		{
+			name: "should copy an empty builder",
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb
+			},
+		},
+		{
+			name: "should copy a select with every clause",
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					With("active", func(q QueryBuilder) {
+						q.Select("id").From("users").Where("active", "=", true)
+					}).
+					Select("u.id", "u.name").
+					AddSelectRaw("? AS source", "web").
+					DistinctOn("u.id").
+					From("users u").
+					JoinOn("orders o", func(jc JoinClause) {
+						jc.On("o.user_id", "=", "u.id").WhereIn("o.status", "paid", "shipped")
+					}).
+					JoinSub(func(q QueryBuilder) {
+						q.Select("user_id").From("visits")
+					}, "v", "v.user_id", "=", "u.id").
+					Where("u.age", ">", 18).
+					WhereGroup(func(q QueryBuilder) {
+						q.Where("u.role", "=", "admin").OrWhereNull("u.role")
+					}).
+					WhereSub("u.id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").From("bans").Where("active", "=", false)
+					}).
+					GroupBy("u.id", "u.name").
+					Having("u.id", ">", 0).
+					Union(func(q QueryBuilder) {
+						q.Select("id", "name").From("admins")
+					}).
+					OrderBy("u.id", "ASC").
+					Limit(10).
+					Offset(20)
+			},
+		},
+		{
+			name: "should copy an upsert",
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Insert("users").
+					Columns("email", "name").
+					Values("a@b.c", "ann").
+					OnConflict("email").
+					DoUpdateSetExcluded("name").
+					DoUpdateWhere(func(q QueryBuilder) {
+						q.Where("users.locked", "=", false)
+					}).
+					Returning("id")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			original := tt.build(New(PostgresDialect{}))
+
+			// Act
+			cloned := original.Clone()
+
+			// Assert
+			assert.Equal(t, original, cloned, "expected clone to match the original")
+			assert.NotSame(t, original, cloned, "expected clone to be a new builder")
+
+			originalSQL, originalArgs, originalErr := original.ToSQL()
+			clonedSQL, clonedArgs, clonedErr := cloned.ToSQL()
+			assert.Equal(t, originalErr, clonedErr, "expected clone error to match")
+			assert.Equal(t, originalSQL, clonedSQL, "expected clone SQL to match")
+			assert.Equal(t, originalArgs, clonedArgs, "expected clone args to match")
+		})
+	}
+}
+
+func TestBuilder_Clone_Isolation(t *testing.T) {
+	t.Parallel()
+
+	base := func() QueryBuilder {
+		return New(PostgresDialect{}).
+			Select("id").
+			From("users").
+			Where("active", "=", true).
+			WhereGroup(func(q QueryBuilder) {
+				q.Where("role", "=", "admin")
+			}).
+			WhereSub("id", "IN", func(q QueryBuilder) {
+				q.Select("user_id").From("orders").Where("status", "=", "paid")
+			})
+	}
+
+	expectedSQL := `SELECT "id" FROM "users" WHERE "active" = $1 AND ("role" = $2) AND "id" IN (SELECT "user_id" FROM "orders" WHERE "status" = $3)`
+	expectedArgs := []any{true, "admin", "paid"}
+
+	tests := []struct {
+		name   string
+		mutate func(*builder)
+	}{
+		{
+			name: "should not share appended clauses",
+			mutate: func(c *builder) {
+				c.Where("age", ">", 18).OrderBy("id", "DESC").Limit(5).Join("orders", "orders.user_id", "=", "users.id")
+			},
+		},
+		{
+			name: "should not share where args",
+			mutate: func(c *builder) {
+				c.wheres[0].args[0] = false
+			},
+		},
+		{
+			name: "should not share nested where trees",
+			mutate: func(c *builder) {
+				c.wheres[1].nested[0].args[0] = "guest"
+				c.wheres[1].nested = append(c.wheres[1].nested, where{queryType: QueryNull, conj: "OR", column: "role", operator: "IS NULL"})
+			},
+		},
+		{
+			name: "should not share subquery builders",
+			mutate: func(c *builder) {
+				c.wheres[2].sub.Where("total", ">", 100)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			original := base()
+			cloned := original.Clone().(*builder)
+
+			// Act
+			tt.mutate(cloned)
+			sql, args, err := original.ToSQL()
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, expectedSQL, sql, "expected original SQL to be unchanged")
+			assert.Equal(t, expectedArgs, args, "expected original args to be unchanged")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkBuilder_Clone(b *testing.B) {
+	qb := New(PostgresDialect{}).
+		Select("id", "name").
+		From("users").
+		Where("active", "=", true).
+		WhereIn("role", "admin", "owner").
+		OrderBy("id", "ASC").
+		Limit(10)
+
+	for b.Loop() {
+		qb.Clone()
+	}
+}
@@ -0,0 +1,1210 @@
+package sequel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// compiler holds the clause compilers shared by every dialect and writes the
+// statement skeleton around them. A dialect only sets the flags and hooks
+// where its SQL actually differs (pagination, upserts, RETURNING, ...).
+type compiler struct {
+	dialect Dialect
+
+	// omitRecursive drops the RECURSIVE keyword for dialects that detect
+	// recursive CTEs on their own and reject it (SQL Server, Oracle).
+	omitRecursive bool
+
+	// omitTableAs aliases derived tables without AS, which Oracle rejects.
+	omitTableAs bool
+
+	// selectOnlyCTEs rejects WITH on INSERT, UPDATE and DELETE for dialects
+	// that only accept it inside a SELECT (Oracle, ClickHouse).
+	selectOnlyCTEs bool
+
+	// bareCompounds writes compound members without parentheses, which
+	// SQLite rejects.
+	bareCompounds bool
+
+	// compoundOperators respells set operators, such as EXCEPT as MINUS.
+	compoundOperators map[string]string
+
+	// requireWhere writes WHERE 1 for a full-table UPDATE or DELETE, which
+	// ClickHouse rejects without a WHERE clause.
+	requireWhere bool
+
+	// reusePlaceholders writes a repeated :name marker as the placeholder of
+	// its first occurrence. Only numbered placeholders that the driver binds
+	// by number can be reused (Postgres $n, SQL Server @pN, SQLite ?NNN);
+	// Oracle :n and positional ? bind the value again for each occurrence.
+	reusePlaceholders bool
+
+	// backslashEscapes reads a backslash inside a quoted string of a raw
+	// expression as an escape, which MySQL and ClickHouse do by default.
+	backslashEscapes bool
+
+	// aggregates is set while compiling HAVING, where a condition column
+	// may be an aggregate call such as COUNT(*).
+	aggregates bool
+
+	// The hooks below write the parts where dialects differ. A nil hook
+	// writes the standard form.
+
+	// distinct writes DISTINCT / DISTINCT ON ahead of the select list.
+	distinct func(c compiler, b *builder) (string, error)
+
+	// top writes a row limit ahead of the select list.
+	top func(b *builder) string
+
+	// paging writes the row limit after the ORDER BY clause.
+	paging func(c compiler, b *builder) string
+
+	// multiRowInsert writes an insert of several rows for dialects without
+	// multi-row VALUES lists. into is the "INTO table (columns)" part.
+	multiRowInsert func(c compiler, into string, rows [][]any, globalArgs *[]any) string
+
+	// conflict writes the upsert clause after VALUES.
+	conflict func(c compiler, b *builder, globalArgs *[]any) (string, error)
+
+	// output writes RETURNING as an OUTPUT clause ahead of VALUES, FROM and
+	// WHERE instead of a RETURNING clause at the end.
+	output func(c compiler, prefix string, columns []column, globalArgs *[]any) string
+
+	// updateTarget writes everything ahead of the assignments of an UPDATE,
+	// and updateFrom the joined tables after them.
+	updateTarget func(c compiler, b *builder, globalArgs *[]any) (string, error)
+	updateFrom   func(c compiler, b *builder, globalArgs *[]any) (string, error)
+
+	// deleteTarget writes the DELETE ... FROM part, and deleteFrom the joined
+	// tables after it.
+	deleteTarget func(c compiler, b *builder, globalArgs *[]any) (string, error)
+	deleteFrom   func(c compiler, b *builder, globalArgs *[]any) (string, error)
+}
+
+// compileStatement runs a dialect statement compiler with a fresh args list,
+// the counter every placeholder of the statement and its subqueries share.
+func compileStatement(compile func(*builder, *[]any) (string, error), b *builder) (string, []any, error) {
+	args := []any{}
+
+	sql, err := compile(b, &args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql, args, nil
+}
+
+func (c compiler) compileSelect(b *builder, globalArgs *[]any) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	var sb strings.Builder
+
+	// WITH clause
+	if len(b.ctes) > 0 {
+		withClause, err := c.compileWithClause(b.ctes, globalArgs)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(withClause)
+	}
+
+	// SELECT clause
+	sb.WriteString("SELECT ")
+	if b.distinct {
+		distinct := c.distinct
+		if distinct == nil {
+			distinct = compiler.compileDistinctClause
+		}
+
+		distinctClause, err := distinct(c, b)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(distinctClause)
+	}
+	if c.top != nil {
+		sb.WriteString(c.top(b))
+	}
+	selectClause, err := c.compileSelectClause(b.columns, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(selectClause)
+
+	// FROM clause
+	sb.WriteString(" FROM ")
+	fromClause, err := c.compileFromClause(b.table, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(fromClause)
+
+	// FINAL / SAMPLE modifiers (the builder only sets them where supported).
+	// They apply to a table engine, so a derived or raw table has none.
+	if (b.final || b.sample > 0) && (b.table.queryType == QuerySub || b.table.queryType == QueryRaw) {
+		return "", ErrInvalidTableInput
+	}
+	if b.final {
+		sb.WriteString(" FINAL")
+	}
+	if b.sample > 0 {
+		sb.WriteString(" SAMPLE ")
+		sb.WriteString(strconv.FormatFloat(b.sample, 'g', -1, 64))
+	}
+
+	// ARRAY JOIN clause
+	for _, aj := range b.arrayJoins {
+		sb.WriteString(" ")
+		sb.WriteString(aj.joinType)
+		sb.WriteString(" ")
+		sb.WriteString(c.compileColumnExprList(aj.columns))
+	}
+
+	// JOIN clause
+	if len(b.joins) > 0 {
+		joinClause, err := c.compileJoinClause(b.joins, globalArgs)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(joinClause)
+	}
+
+	// PREWHERE clause (recursive)
+	if len(b.preWheres) > 0 {
+		preWhereClause, err := c.compileWhereClause(b.preWheres, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(" PREWHERE ")
+		sb.WriteString(preWhereClause)
+	}
+
+	// WHERE clause (recursive)
+	if len(b.wheres) > 0 {
+		whereClause, err := c.compileWhereClause(b.wheres, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(" WHERE ")
+		sb.WriteString(whereClause)
+	}
+
+	// GROUP BY clause
+	if len(b.groupBys) > 0 {
+		sb.WriteString(" GROUP BY ")
+		sb.WriteString(c.compileGroupByClause(b.groupBys, globalArgs))
+	}
+
+	// HAVING clause (recursive)
+	if len(b.havings) > 0 {
+		hc := c
+		hc.aggregates = true
+
+		havingClause, err := hc.compileWhereClause(b.havings, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(" HAVING ")
+		sb.WriteString(havingClause)
+	}
+
+	// UNION / INTERSECT / EXCEPT
+	if len(b.unions) > 0 {
+		unionClause, err := c.compileUnionClause(b.unions, globalArgs)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(unionClause)
+	}
+
+	// ORDER BY clause (applies to the combined result when unions are present)
+	if len(b.orderBys) > 0 {
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(c.compileOrderByClause(b.orderBys, globalArgs))
+	}
+
+	// LIMIT / OFFSET
+	paging := c.paging
+	if paging == nil {
+		paging = compiler.compileLimitOffset
+	}
+	sb.WriteString(paging(c, b))
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileInsert(b *builder, globalArgs *[]any) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	if b.table.name == "" {
+		return "", ErrEmptyTable
+	}
+
+	if len(b.values) == 0 {
+		return "", ErrEmptyValues
+	}
+
+	var sb strings.Builder
+
+	// WITH clause
+	withClause, err := c.compileDMLWithClause(b.ctes, "INSERT", globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(withClause)
+
+	// INTO table (columns) part
+	var into strings.Builder
+	into.WriteString("INTO ")
+	into.WriteString(c.dialect.WrapTable(b.table.name))
+	if len(b.insertColumns) > 0 {
+		into.WriteString(" (")
+		into.WriteString(c.compileColumnList(b.insertColumns))
+		into.WriteString(")")
+	}
+
+	if c.multiRowInsert != nil && len(b.values) > 1 {
+		sb.WriteString(c.multiRowInsert(c, into.String(), b.values, globalArgs))
+		return sb.String(), nil
+	}
+
+	sb.WriteString("INSERT ")
+	sb.WriteString(into.String())
+
+	// OUTPUT clause (RETURNING)
+	if c.output != nil && len(b.returning) > 0 {
+		sb.WriteString(" OUTPUT ")
+		sb.WriteString(c.output(c, "INSERTED", b.returning, globalArgs))
+	}
+
+	// VALUES clause
+	sb.WriteString(" VALUES ")
+	sb.WriteString(c.compileValuesClause(b.values, globalArgs))
+
+	// ON CONFLICT clause
+	if b.conflict != nil {
+		conflict := c.conflict
+		if conflict == nil {
+			conflict = compiler.compileUpsertClause
+		}
+
+		conflictClause, err := conflict(c, b, globalArgs)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(conflictClause)
+	}
+
+	// RETURNING clause
+	sb.WriteString(c.compileTrailingReturning(b.returning, globalArgs))
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileUpdate(b *builder, globalArgs *[]any) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	if b.table.name == "" {
+		return "", ErrEmptyTable
+	}
+
+	if len(b.sets) == 0 {
+		return "", ErrEmptyValues
+	}
+
+	if matchesEveryRow(b.wheres) && !b.allowFullTable {
+		return "", ErrUnfilteredUpdate
+	}
+
+	var sb strings.Builder
+
+	// WITH clause
+	withClause, err := c.compileDMLWithClause(b.ctes, "UPDATE", globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(withClause)
+
+	// UPDATE clause
+	target := c.updateTarget
+	if target == nil {
+		target = compiler.compileUpdateTarget
+	}
+	targetClause, err := target(c, b, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(targetClause)
+
+	// SET clause
+	sb.WriteString(c.compileSetClause(b.sets, globalArgs))
+
+	// OUTPUT clause (RETURNING)
+	if c.output != nil && len(b.returning) > 0 {
+		sb.WriteString(" OUTPUT ")
+		sb.WriteString(c.output(c, "INSERTED", b.returning, globalArgs))
+	}
+
+	// FROM clause (join-update)
+	from := c.updateFrom
+	if from == nil {
+		from = compiler.compileUpdateFrom
+	}
+	fromClause, err := from(c, b, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(fromClause)
+
+	// WHERE clause (recursive)
+	whereClause, err := c.compileDMLWhereClause(b.wheres, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(whereClause)
+
+	// RETURNING clause
+	sb.WriteString(c.compileTrailingReturning(b.returning, globalArgs))
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileDelete(b *builder, globalArgs *[]any) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	// a derived or raw table has nothing to delete from
+	if b.table.queryType == QuerySub || b.table.queryType == QueryRaw {
+		return "", ErrInvalidTableInput
+	}
+
+	if b.table.name == "" {
+		return "", ErrEmptyTable
+	}
+
+	if matchesEveryRow(b.wheres) && !b.allowFullTable {
+		return "", ErrUnfilteredDelete
+	}
+
+	var sb strings.Builder
+
+	// WITH clause
+	withClause, err := c.compileDMLWithClause(b.ctes, "DELETE", globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(withClause)
+
+	// DELETE FROM clause
+	target := c.deleteTarget
+	if target == nil {
+		target = compiler.compileDeleteTarget
+	}
+	targetClause, err := target(c, b, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(targetClause)
+
+	// OUTPUT clause (RETURNING)
+	if c.output != nil && len(b.returning) > 0 {
+		sb.WriteString(" OUTPUT ")
+		sb.WriteString(c.output(c, "DELETED", b.returning, globalArgs))
+	}
+
+	// USING clause (joined delete)
+	from := c.deleteFrom
+	if from == nil {
+		from = compiler.compileDeleteUsing
+	}
+	fromClause, err := from(c, b, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(fromClause)
+
+	// WHERE clause (recursive)
+	whereClause, err := c.compileDMLWhereClause(b.wheres, globalArgs)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(whereClause)
+
+	// RETURNING clause
+	sb.WriteString(c.compileTrailingReturning(b.returning, globalArgs))
+
+	return sb.String(), nil
+}
+
+// compileDistinctClause writes a plain DISTINCT. DISTINCT ON needs a
+// dialect hook.
+func (c compiler) compileDistinctClause(b *builder) (string, error) {
+	if len(b.distinctOn) > 0 {
+		return "", unsupportedFeature("DISTINCT ON")
+	}
+
+	return "DISTINCT ", nil
+}
+
+func (c compiler) compileLimitOffset(b *builder) string {
+	var sb strings.Builder
+
+	if b.limit >= 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", b.limit))
+	}
+	if b.offset >= 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", b.offset))
+	}
+
+	return sb.String()
+}
+
+// compileDMLWithClause compiles the WITH clause of an INSERT, UPDATE or
+// DELETE, where statement names the statement in the unsupported error.
+func (c compiler) compileDMLWithClause(ctes []cte, statement string, globalArgs *[]any) (string, error) {
+	if len(ctes) == 0 {
+		return "", nil
+	}
+
+	if c.selectOnlyCTEs {
+		return "", unsupportedFeature("WITH " + statement)
+	}
+
+	return c.compileWithClause(ctes, globalArgs)
+}
+
+func (c compiler) compileUpsertClause(b *builder, globalArgs *[]any) (string, error) {
+	return c.compileConflictClause(b.conflict, globalArgs)
+}
+
+func (c compiler) compileUpdateTarget(b *builder, globalArgs *[]any) (string, error) {
+	return "UPDATE " + c.dialect.WrapTable(b.table.name) + " SET ", nil
+}
+
+func (c compiler) compileUpdateFrom(b *builder, globalArgs *[]any) (string, error) {
+	if len(b.using) == 0 {
+		return "", nil
+	}
+
+	fromClause, err := c.compileTableList(b.using, globalArgs)
+	if err != nil {
+		return "", err
+	}
+
+	return " FROM " + fromClause, nil
+}
+
+func (c compiler) compileDeleteTarget(b *builder, globalArgs *[]any) (string, error) {
+	return "DELETE FROM " + c.dialect.WrapTable(b.table.name), nil
+}
+
+func (c compiler) compileDeleteUsing(b *builder, globalArgs *[]any) (string, error) {
+	if len(b.using) == 0 {
+		return "", nil
+	}
+
+	usingClause, err := c.compileTableList(b.using, globalArgs)
+	if err != nil {
+		return "", err
+	}
+
+	return " USING " + usingClause, nil
+}
+
+// rejectUpdateFrom and rejectDeleteUsing are the hooks of dialects without
+// joined updates or deletes, which filter with WhereSub/WhereExists instead.
+func (c compiler) rejectUpdateFrom(b *builder, globalArgs *[]any) (string, error) {
+	if len(b.using) > 0 {
+		return "", unsupportedFeature("UPDATE FROM")
+	}
+
+	return "", nil
+}
+
+func (c compiler) rejectDeleteUsing(b *builder, globalArgs *[]any) (string, error) {
+	if len(b.using) > 0 {
+		return "", unsupportedFeature("DELETE USING")
+	}
+
+	return "", nil
+}
+
+func (c compiler) compileDMLWhereClause(wheres []where, globalArgs *[]any) (string, error) {
+	if len(wheres) == 0 {
+		if c.requireWhere {
+			return " WHERE 1", nil
+		}
+
+		return "", nil
+	}
+
+	whereClause, err := c.compileWhereClause(wheres, globalArgs)
+	if err != nil {
+		return "", err
+	}
+
+	return " WHERE " + whereClause, nil
+}
+
+func (c compiler) compileTrailingReturning(columns []column, globalArgs *[]any) string {
+	if c.output != nil || len(columns) == 0 {
+		return ""
+	}
+
+	return " RETURNING " + c.compileReturningClause(columns, globalArgs)
+}
+
+// compileSub compiles a nested builder straight into globalArgs, so its
+// placeholders continue the numbering of the outer statement.
+func (c compiler) compileSub(sub QueryBuilder, globalArgs *[]any) (string, error) {
+	return sub.(*builder).compile(globalArgs)
+}
+
+// bindRaw replaces the "?" or :name markers of a raw expression with
+// placeholders. The builder already checked that markers and args line up.
+func (c compiler) bindRaw(expr string, args []any, globalArgs *[]any) string {
+	if named, ok := namedArgs(expr, args, c.backslashEscapes); ok {
+		return c.bindNamed(expr, named, globalArgs)
+	}
+
+	i := 0
+	sql, _ := rewriteRaw(expr, c.backslashEscapes, func() string {
+		if i >= len(args) {
+			return "?"
+		}
+
+		*globalArgs = append(*globalArgs, args[i])
+		i++
+
+		return c.dialect.Placeholder(len(*globalArgs))
+	}, nil)
+
+	return sql
+}
+
+// bindNamed binds the :name markers of a raw expression. A repeated name
+// reuses its placeholder when the compiler allows it and binds the value again
+// otherwise.
+func (c compiler) bindNamed(expr string, named map[string]any, globalArgs *[]any) string {
+	bound := make(map[string]string, len(named))
+
+	sql, _ := rewriteRaw(expr, c.backslashEscapes, func() string { return "?" }, func(name string) string {
+		if placeholder, ok := bound[name]; ok && c.reusePlaceholders {
+			return placeholder
+		}
+
+		*globalArgs = append(*globalArgs, named[name])
+		placeholder := c.dialect.Placeholder(len(*globalArgs))
+		bound[name] = placeholder
+
+		return placeholder
+	})
+
+	return sql
+}
+
+func (c compiler) compileWithClause(ctes []cte, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString("WITH ")
+	for _, ct := range ctes {
+		if ct.recursive && !c.omitRecursive {
+			sb.WriteString("RECURSIVE ")
+			break
+		}
+	}
+
+	for i, ct := range ctes {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		subSQL, err := c.compileSub(ct.sub, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(c.dialect.WrapIdentifier(ct.name))
+		if len(ct.columns) > 0 {
+			sb.WriteString(" (")
+			sb.WriteString(c.compileColumnList(ct.columns))
+			sb.WriteString(")")
+		}
+
+		sb.WriteString(" AS ")
+		if ct.materialized != "" {
+			sb.WriteString(ct.materialized)
+			sb.WriteString(" ")
+		}
+		sb.WriteString("(")
+		sb.WriteString(subSQL)
+		sb.WriteString(")")
+	}
+	sb.WriteString(" ")
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileColumnList(columns []string) string {
+	var sb strings.Builder
+
+	for i, col := range columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(c.dialect.WrapIdentifier(col))
+	}
+
+	return sb.String()
+}
+
+func (c compiler) compileSelectClause(columns []column, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	if len(columns) == 0 {
+		sb.WriteString("*")
+	} else {
+		for i, col := range columns {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			switch col.queryType {
+			case QueryBasic:
+				sb.WriteString(c.dialect.WrapColumn(col.name))
+
+			case QueryRaw:
+				sb.WriteString(c.bindRaw(col.expr, col.args, globalArgs))
+
+			case QuerySub:
+				subSQL, err := c.compileSub(col.sub, globalArgs)
+				if err != nil {
+					return "", err
+				}
+
+				sb.WriteString("(")
+				sb.WriteString(subSQL)
+				sb.WriteString(")")
+				sb.WriteString(" AS ")
+				sb.WriteString(c.dialect.WrapIdentifier(col.name))
+			}
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileFromClause(table table, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	switch table.queryType {
+	case QueryBasic:
+		return c.dialect.WrapTable(table.name), nil
+
+	case QueryRaw:
+		sb.WriteString(c.bindRaw(table.expr, table.args, globalArgs))
+
+	case QuerySub:
+		subSQL, err := c.compileSub(table.sub, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString("(")
+		sb.WriteString(subSQL)
+		sb.WriteString(")")
+
+		if table.name != "" {
+			if c.omitTableAs {
+				sb.WriteString(" ")
+			} else {
+				sb.WriteString(" AS ")
+			}
+			sb.WriteString(c.dialect.WrapIdentifier(table.name))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// compileTableList compiles the extra tables of UPDATE ... FROM and
+// DELETE ... USING as a comma separated list.
+func (c compiler) compileTableList(tables []table, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	for i, t := range tables {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		tableClause, err := c.compileFromClause(t, globalArgs)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(tableClause)
+	}
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileJoinClause(joins []join, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	for _, j := range joins {
+		switch j.queryType {
+		case QueryBasic:
+			sb.WriteString(" ")
+			sb.WriteString(j.joinType)
+			sb.WriteString(" ")
+			sb.WriteString(c.dialect.WrapTable(j.table))
+
+			// CROSS and NATURAL joins take no condition
+			if len(j.using) > 0 {
+				sb.WriteString(" USING (")
+				sb.WriteString(c.compileColumnList(j.using))
+				sb.WriteString(")")
+			} else if j.leftCol != "" {
+				sb.WriteString(" ON ")
+				sb.WriteString(c.dialect.WrapColumn(j.leftCol))
+				sb.WriteString(" ")
+				sb.WriteString(j.operator)
+				sb.WriteString(" ")
+				sb.WriteString(c.dialect.WrapColumn(j.rightCol))
+			}
+
+		case QueryNested:
+			onClause, err := c.compileWhereClause(j.on, globalArgs)
+			if err != nil {
+				return "", err
+			}
+
+			sb.WriteString(" ")
+			sb.WriteString(j.joinType)
+			sb.WriteString(" ")
+			sb.WriteString(c.dialect.WrapTable(j.table))
+			sb.WriteString(" ON ")
+			sb.WriteString(onClause)
+
+		case QuerySub:
+			subSQL, err := c.compileSub(j.sub, globalArgs)
+			if err != nil {
+				return "", err
+			}
+
+			sb.WriteString(" ")
+			sb.WriteString(j.joinType)
+			if j.lateral {
+				sb.WriteString(" LATERAL")
+			}
+			sb.WriteString(" (")
+			sb.WriteString(subSQL)
+			sb.WriteString(")")
+			if c.omitTableAs {
+				sb.WriteString(" ")
+			} else {
+				sb.WriteString(" AS ")
+			}
+			sb.WriteString(c.dialect.WrapIdentifier(j.table))
+
+			// a lateral subquery is correlated in its own WHERE clause
+			if j.lateral {
+				sb.WriteString(" ON TRUE")
+			} else {
+				sb.WriteString(" ON ")
+				sb.WriteString(c.dialect.WrapColumn(j.leftCol))
+				sb.WriteString(" ")
+				sb.WriteString(j.operator)
+				sb.WriteString(" ")
+				sb.WriteString(c.dialect.WrapColumn(j.rightCol))
+			}
+
+		case QueryRaw:
+			sb.WriteString(" ")
+			sb.WriteString(c.bindRaw(j.expr, j.args, globalArgs))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// Recursive WHERE compiler
+// matchesEveryRow reports whether conditions hold for every row, either
+// because there are none or because they only compare with an empty NOT IN
+// list, which compiles to 1 = 1. AND binds tighter than OR, so a single OR
+// branch made of such conditions is enough.
+func matchesEveryRow(wheres []where) bool {
+	branch := true
+	for i, w := range wheres {
+		if i > 0 && w.conj == "OR" {
+			if branch {
+				return true
+			}
+			branch = true
+		}
+
+		branch = branch && alwaysTrue(w)
+	}
+
+	return branch
+}
+
+func alwaysTrue(w where) bool {
+	switch w.queryType {
+	case QueryIn:
+		return w.operator == "NOT IN" && len(w.args) == 0
+	case QueryNested:
+		return matchesEveryRow(w.nested)
+	}
+
+	return false
+}
+
+func (c compiler) compileWhereClause(wheres []where, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	for i, w := range wheres {
+		if i > 0 {
+			sb.WriteString(" ")
+			sb.WriteString(w.conj)
+			sb.WriteString(" ")
+		}
+
+		switch w.queryType {
+		case QueryBasic:
+			sb.WriteString(c.compileConditionColumn(w.column))
+			sb.WriteString(" ")
+			sb.WriteString(w.operator)
+			sb.WriteString(" ")
+			sb.WriteString(c.dialect.Placeholder(len(*globalArgs) + 1))
+			*globalArgs = append(*globalArgs, w.args...)
+
+		case QueryColumn:
+			sb.WriteString(c.compileConditionColumn(w.column))
+			sb.WriteString(" ")
+			sb.WriteString(w.operator)
+			sb.WriteString(" ")
+			sb.WriteString(c.compileConditionColumn(w.expr))
+
+		case QueryExpr:
+			sb.WriteString(c.compileConditionColumn(w.column))
+			sb.WriteString(" ")
+			sb.WriteString(w.operator)
+			sb.WriteString(" ")
+			sb.WriteString(c.bindRaw(w.expr, w.args, globalArgs))
+
+		case QueryBetween:
+			sb.WriteString("(")
+			sb.WriteString(c.compileConditionColumn(w.column))
+			sb.WriteString(" ")
+			sb.WriteString(w.operator)
+			sb.WriteString(" ")
+			sb.WriteString(c.dialect.Placeholder(len(*globalArgs) + 1))
+			sb.WriteString(" AND ")
+			sb.WriteString(c.dialect.Placeholder(len(*globalArgs) + 2))
+			sb.WriteString(")")
+			*globalArgs = append(*globalArgs, w.args...)
+
+		case QueryIn:
+			if len(w.args) == 0 {
+				if w.operator == "NOT IN" {
+					sb.WriteString("1 = 1")
+				} else {
+					sb.WriteString("1 = 0")
+				}
+				continue
+			}
+
+			sb.WriteString(c.compileConditionColumn(w.column))
+			sb.WriteString(" ")
+			sb.WriteString(w.operator)
+			sb.WriteString(" (")
+
+			for i := range w.args {
+				if i > 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteString(c.dialect.Placeholder(len(*globalArgs) + i + 1))
+			}
+			sb.WriteString(")")
+			*globalArgs = append(*globalArgs, w.args...)
+
+		case QueryNull:
+			sb.WriteString(c.compileConditionColumn(w.column))
+			sb.WriteString(" ")
+			sb.WriteString(w.operator)
+
+		case QueryRaw:
+			sb.WriteString(c.bindRaw(w.expr, w.args, globalArgs))
+
+		case QueryNested:
+			whereClause, err := c.compileWhereClause(w.nested, globalArgs) // recursion updates globalArgs directly
+			if err != nil {
+				return "", err
+			}
+
+			sb.WriteString("(")
+			sb.WriteString(whereClause)
+			sb.WriteString(")")
+
+		case QuerySub:
+			subSQL, err := c.compileSub(w.sub, globalArgs)
+			if err != nil {
+				return "", err
+			}
+
+			if w.column != "" {
+				sb.WriteString(c.compileConditionColumn(w.column))
+				sb.WriteString(" ")
+			}
+			sb.WriteString(w.operator)
+			sb.WriteString(" (")
+			sb.WriteString(subSQL)
+			sb.WriteString(")")
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// compileConditionColumn quotes the column of a condition. In HAVING an
+// aggregate call keeps its function unquoted and quotes only its column.
+func (c compiler) compileConditionColumn(column string) string {
+	if c.aggregates {
+		if prefix, arg, ok := splitAggregate(column); ok {
+			return prefix + c.dialect.WrapIdentifier(arg) + ")"
+		}
+	}
+
+	return c.dialect.WrapColumn(column)
+}
+
+func (c compiler) compileGroupByClause(groupBys []groupBy, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	for i, g := range groupBys {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		switch g.queryType {
+		case QueryBasic:
+			sb.WriteString(c.dialect.WrapColumn(g.column))
+		case QueryRaw:
+			sb.WriteString(c.bindRaw(g.expr, g.args, globalArgs))
+		}
+	}
+
+	return sb.String()
+}
+
+func (c compiler) compileUnionClause(unions []union, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	for _, u := range unions {
+		// without parentheses a member's own ORDER BY or LIMIT would apply
+		// to the whole compound
+		if c.bareCompounds {
+			if sub := u.sub.(*builder); len(sub.orderBys) > 0 || sub.limit >= 0 || sub.offset >= 0 {
+				return "", unsupportedFeature("ORDER BY, LIMIT or OFFSET in a compound member")
+			}
+		}
+
+		subSQL, err := c.compileSub(u.sub, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		operator := u.operator
+		if spelled, ok := c.compoundOperators[operator]; ok {
+			operator = spelled
+		}
+
+		sb.WriteString(" ")
+		sb.WriteString(operator)
+		sb.WriteString(" ")
+		if c.bareCompounds {
+			sb.WriteString(subSQL)
+		} else {
+			sb.WriteString("(")
+			sb.WriteString(subSQL)
+			sb.WriteString(")")
+		}
+	}
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileOrderByClause(orderBys []orderBy, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	for i, ob := range orderBys {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		switch ob.queryType {
+		case QueryBasic:
+			sb.WriteString(c.dialect.WrapColumn(ob.column))
+			sb.WriteString(" ")
+			sb.WriteString(ob.dir)
+		case QueryRaw:
+			sb.WriteString(c.bindRaw(ob.expr, ob.args, globalArgs))
+		}
+	}
+
+	return sb.String()
+}
+
+func (c compiler) compileValuesClause(rows [][]any, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString("(")
+		for j, v := range row {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			switch v := v.(type) {
+			case ColumnRef:
+				sb.WriteString(c.dialect.WrapColumn(v.name))
+			case Expression:
+				sb.WriteString(c.bindRaw(v.expr, v.args, globalArgs))
+			default:
+				sb.WriteString(c.dialect.Placeholder(len(*globalArgs) + 1))
+				*globalArgs = append(*globalArgs, v)
+			}
+		}
+		sb.WriteString(")")
+	}
+
+	return sb.String()
+}
+
+func (c compiler) compileSetClause(sets []set, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	for i, s := range sets {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		switch s.queryType {
+		case QueryBasic:
+			sb.WriteString(c.dialect.WrapIdentifier(s.column))
+			sb.WriteString(" = ")
+			sb.WriteString(c.dialect.Placeholder(len(*globalArgs) + 1))
+			*globalArgs = append(*globalArgs, s.args...)
+		case QueryColumn:
+			sb.WriteString(c.dialect.WrapIdentifier(s.column))
+			sb.WriteString(" = ")
+			sb.WriteString(c.dialect.WrapColumn(s.expr))
+		case QueryExpr:
+			sb.WriteString(c.dialect.WrapIdentifier(s.column))
+			sb.WriteString(" = ")
+			sb.WriteString(c.bindRaw(s.expr, s.args, globalArgs))
+		case QueryExcluded:
+			sb.WriteString(c.dialect.WrapIdentifier(s.column))
+			sb.WriteString(" = EXCLUDED.")
+			sb.WriteString(c.dialect.WrapIdentifier(s.column))
+		case QueryRaw:
+			sb.WriteString(c.bindRaw(s.expr, s.args, globalArgs))
+		}
+	}
+
+	return sb.String()
+}
+
+func (c compiler) compileConflictClause(oc *onConflict, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString(" ON CONFLICT")
+
+	// Conflict target
+	switch {
+	case oc.constraint != "":
+		sb.WriteString(" ON CONSTRAINT ")
+		sb.WriteString(c.dialect.WrapIdentifier(oc.constraint))
+	case len(oc.columns) > 0:
+		sb.WriteString(" (")
+		sb.WriteString(c.compileColumnList(oc.columns))
+		sb.WriteString(")")
+	}
+
+	// Conflict action
+	switch oc.action {
+	case "NOTHING":
+		sb.WriteString(" DO NOTHING")
+
+	case "UPDATE":
+		// DO UPDATE needs a conflict target and at least one assignment
+		if (oc.constraint == "" && len(oc.columns) == 0) || len(oc.sets) == 0 {
+			return "", ErrInvalidConflict
+		}
+
+		sb.WriteString(" DO UPDATE SET ")
+		sb.WriteString(c.compileSetClause(oc.sets, globalArgs))
+
+		if len(oc.wheres) > 0 {
+			whereClause, err := c.compileWhereClause(oc.wheres, globalArgs)
+			if err != nil {
+				return "", err
+			}
+
+			sb.WriteString(" WHERE ")
+			sb.WriteString(whereClause)
+		}
+
+	default:
+		return "", ErrInvalidConflict
+	}
+
+	return sb.String(), nil
+}
+
+func (c compiler) compileReturningClause(columns []column, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	for i, col := range columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		switch col.queryType {
+		case QueryBasic:
+			if col.name == "*" {
+				sb.WriteString("*")
+				continue
+			}
+			sb.WriteString(c.dialect.WrapColumn(col.name))
+		case QueryRaw:
+			sb.WriteString(c.bindRaw(col.expr, col.args, globalArgs))
+		}
+	}
+
+	return sb.String()
+}
+
+// compileColumnExprList compiles DISTINCT ON, ARRAY JOIN and LIMIT BY
+// columns, which unlike insert columns may carry an alias.
+func (c compiler) compileColumnExprList(columns []string) string {
+	var sb strings.Builder
+
+	for i, col := range columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(c.dialect.WrapColumn(col))
+	}
+
+	return sb.String()
+}
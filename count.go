@@ -0,0 +1,51 @@
+package sequel
+
+// countAlias names the derived table a count query wraps its source in.
+const countAlias = "count_sub"
+
+// ToCountSQL compiles a COUNT(*) of the rows the select builder returns,
+// ignoring ORDER BY, LIMIT and OFFSET. The builder itself is left untouched.
+func (b *builder) ToCountSQL() (string, []any, error) {
+	if b.dialect == nil {
+		return "", nil, ErrNoDialect
+	}
+
+	if b.action != "select" {
+		return "", nil, ErrUnsupportedAction
+	}
+
+	return b.countQuery().ToSQL()
+}
+
+// countQuery derives the count query from a copy of the builder. DISTINCT,
+// GROUP BY, HAVING, LIMIT BY and compound selects change the number of rows,
+// so those are counted through a subquery; anything else only swaps the
+// select list for COUNT(*).
+func (b *builder) countQuery() *builder {
+	c := b.clone()
+	c.orderBys = nil
+	c.limit = -1
+	c.offset = -1
+
+	if !c.distinct && len(c.groupBys) == 0 && len(c.havings) == 0 && len(c.unions) == 0 && c.limitBy == nil {
+		c.columns = []column{{queryType: QueryRaw, expr: "COUNT(*)"}}
+		return c
+	}
+
+	outer := New(b.dialect).(*builder)
+	outer.action = "select"
+	outer.columns = []column{{queryType: QueryRaw, expr: "COUNT(*)"}}
+
+	// CTEs stay on the outer statement, some dialects reject WITH in a
+	// derived table
+	outer.ctes = c.ctes
+	c.ctes = nil
+
+	outer.table = table{
+		queryType: QuerySub,
+		sub:       c,
+		name:      countAlias,
+	}
+
+	return outer
+}
@@ -0,0 +1,215 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_ToCountSQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       Dialect
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name:    "should replace the select list and drop ordering and paging",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Select("id", "name").
+					From("users").
+					Where("active", "=", true).
+					OrderBy("name", "ASC").
+					Limit(20).
+					Offset(40)
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM "users" WHERE "active" = $1`,
+			expectedArgs: []any{true},
+		},
+		{
+			name:    "should keep joins and drop select args",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Select("users.id").
+					AddSelectRaw("? AS source", "web").
+					From("users").
+					JoinOn("orders", func(jc JoinClause) {
+						jc.On("orders.user_id", "=", "users.id").Where("orders.status", "=", "paid")
+					}).
+					Where("users.age", ">", 18)
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM "users" INNER JOIN "orders" ON "orders"."user_id" = "users"."id" AND "orders"."status" = $1 WHERE "users"."age" > $2`,
+			expectedArgs: []any{"paid", 18},
+		},
+		{
+			name:    "should wrap a DISTINCT select in a subquery",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Select("email").
+					Distinct().
+					From("users").
+					Where("active", "=", true).
+					OrderBy("email", "ASC").
+					Limit(10)
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM (SELECT DISTINCT "email" FROM "users" WHERE "active" = $1) AS "count_sub"`,
+			expectedArgs: []any{true},
+		},
+		{
+			name:    "should wrap a grouped select in a subquery",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Select("user_id").
+					AddSelectRaw("SUM(total) AS spent").
+					From("orders").
+					GroupBy("user_id").
+					HavingRaw("SUM(total) > ?", 100).
+					OrderByRaw("spent DESC").
+					Limit(5)
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM (SELECT "user_id", SUM(total) AS spent FROM "orders" GROUP BY "user_id" HAVING SUM(total) > $1) AS "count_sub"`,
+			expectedArgs: []any{100},
+		},
+		{
+			name:    "should wrap a union in a subquery",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Select("id").
+					From("users").
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins").Where("level", ">", 2)
+					}).
+					OrderBy("id", "ASC")
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM (SELECT "id" FROM "users" UNION (SELECT "id" FROM "admins" WHERE "level" > $1)) AS "count_sub"`,
+			expectedArgs: []any{2},
+		},
+		{
+			name:    "should keep CTEs on the outer statement",
+			dialect: SQLServerDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					With("recent", func(q QueryBuilder) {
+						q.Select("user_id").From("orders").Where("year", "=", 2024)
+					}).
+					Select("user_id").
+					Distinct().
+					From("recent").
+					OrderBy("user_id", "ASC").
+					Limit(10).
+					Offset(10)
+			},
+			expectedSQL:  `WITH [recent] AS (SELECT [user_id] FROM [orders] WHERE [year] = @p1) SELECT COUNT(*) FROM (SELECT DISTINCT [user_id] FROM [recent]) AS [count_sub]`,
+			expectedArgs: []any{2024},
+		},
+		{
+			name:    "should alias the subquery without AS for Oracle",
+			dialect: OracleDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.
+					Select("department_id").
+					From("employees").
+					GroupBy("department_id")
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM (SELECT "DEPARTMENT_ID" FROM "EMPLOYEES" GROUP BY "DEPARTMENT_ID") "COUNT_SUB"`,
+			expectedArgs: []any{},
+		},
+		{
+			name:    "should return the builder error",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.Select("id").From("")
+			},
+			expectedError: ErrEmptyTable,
+		},
+		{
+			name:    "should return an error for a non-select builder",
+			dialect: PostgresDialect{},
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.DeleteFrom("users").Where("id", "=", 1)
+			},
+			expectedError: ErrUnsupportedAction,
+		},
+		{
+			name: "should return an error without a dialect",
+			build: func(qb QueryBuilder) QueryBuilder {
+				return qb.Select("id").From("users")
+			},
+			expectedError: ErrNoDialect,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			qb := tt.build(New(tt.dialect))
+
+			// Act
+			sql, args, err := qb.ToCountSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestBuilder_ToCountSQL_KeepsBuilder(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	qb := New(PostgresDialect{}).
+		Select("id").
+		Distinct().
+		From("users").
+		Where("active", "=", true).
+		OrderBy("id", "DESC").
+		Limit(10)
+
+	// Act
+	_, _, countErr := qb.ToCountSQL()
+	sql, args, err := qb.ToSQL()
+
+	// Assert
+	assert.NoError(t, countErr, "expected no error")
+	assert.NoError(t, err, "expected no error")
+	assert.Equal(t, `SELECT DISTINCT "id" FROM "users" WHERE "active" = $1 ORDER BY "id" DESC LIMIT 10`, sql, "expected page SQL to be unchanged")
+	assert.Equal(t, []any{true}, args, "expected page args to be unchanged")
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkBuilder_ToCountSQL(b *testing.B) {
+	qb := New(PostgresDialect{}).
+		Select("id", "name").
+		From("users").
+		Where("active", "=", true).
+		OrderBy("name", "ASC").
+		Limit(20)
+
+	for b.Loop() {
+		qb.ToCountSQL()
+	}
+}
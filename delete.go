@@ -0,0 +1,29 @@
+package sequel
+
+func (b *builder) Delete() QueryBuilder {
+	b.action = "delete"
+	return b
+}
+
+func (b *builder) DeleteFrom(tbl string) QueryBuilder {
+	return b.Delete().From(tbl)
+}
+
+func (b *builder) Using(tbl string) QueryBuilder {
+	if tbl == "" {
+		b.addErr(ErrEmptyTable)
+		return b
+	}
+
+	if !b.validTable(tbl) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
+	b.using = append(b.using, table{
+		queryType: QueryBasic,
+		name:      tbl,
+	})
+
+	return b
+}
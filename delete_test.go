@@ -0,0 +1,138 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Delete(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := &builder{}
+
+	// Act
+	result := b.Delete()
+
+	// Assert
+	assert.NoError(t, b.err, "expected no error")
+	assert.Equal(t, "delete", b.action, "expected action to be delete")
+	assert.Empty(t, b.table, "expected table to be untouched")
+	assert.Equal(t, b, result, "expected Delete() to return the same builder instance")
+}
+
+func TestBuilder_DeleteFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		table         string
+		expectedTable table
+		expectedError error
+	}{
+		{
+			name:  "should set action and table",
+			table: "users",
+			expectedTable: table{
+				queryType: QueryBasic,
+				name:      "users",
+			},
+		},
+		{
+			name:  "should set aliased table",
+			table: "users u",
+			expectedTable: table{
+				queryType: QueryBasic,
+				name:      "users u",
+			},
+		},
+		{
+			name:          "should return error when table is empty",
+			table:         "",
+			expectedTable: table{},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{}
+
+			// Act
+			result := b.DeleteFrom(tt.table)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, "delete", b.action, "expected action to be delete")
+			assert.Equal(t, tt.expectedTable, b.table, "expected table to be set correctly")
+			assert.Equal(t, b, result, "expected DeleteFrom() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_Using(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		initialUsing  []table
+		table         string
+		expectedUsing []table
+		expectedError error
+	}{
+		{
+			name:  "should add a USING table",
+			table: "orders",
+			expectedUsing: []table{
+				{queryType: QueryBasic, name: "orders"},
+			},
+		},
+		{
+			name: "should append another USING table",
+			initialUsing: []table{
+				{queryType: QueryBasic, name: "orders"},
+			},
+			table: "customers c",
+			expectedUsing: []table{
+				{queryType: QueryBasic, name: "orders"},
+				{queryType: QueryBasic, name: "customers c"},
+			},
+		},
+		{
+			name:          "should return error when table is empty",
+			table:         "",
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{using: tt.initialUsing}
+
+			// Act
+			result := b.Using(tt.table)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedUsing, b.using, "expected USING tables to be updated correctly")
+			assert.Equal(t, b, result, "expected Using() to return the same builder instance")
+		})
+	}
+}
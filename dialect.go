@@ -3,16 +3,38 @@ package sequel
 type Dialect interface {
 	Capabilities() DialectCapabilities
 	Placeholder(n int) string
+	SupportsOperator(operator string) bool
+	ValidIdentifier(identifier string) bool
 	WrapColumn(expr string) string
 	WrapIdentifier(identifier string) string
 	WrapTable(expr string) string
 
 	CompileSelect(b *builder) (string, []any, error)
+	CompileInsert(b *builder) (string, []any, error)
+	CompileUpdate(b *builder) (string, []any, error)
+	CompileDelete(b *builder) (string, []any, error)
+
+	// compiler returns the statement compiler configured with the hooks
+	// of the dialect. Its compilers write into the args of an enclosing
+	// statement so nested builders share its placeholder counter.
+	compiler() compiler
 }
 
 type DialectCapabilities struct {
-	SupportsExcept    bool
-	SupportsFullJoin  bool
-	SupportsIntersect bool
-	SupportsReturning bool
+	SupportsArrayJoin       bool
+	SupportsDistinctOn      bool
+	SupportsExcept          bool
+	SupportsFinal           bool
+	SupportsFullJoin        bool
+	SupportsIntersect       bool
+	SupportsJoinUsing       bool
+	SupportsLateral         bool
+	SupportsLimitBy         bool
+	SupportsMaterializedCTE bool
+	SupportsNaturalJoin     bool
+	SupportsPreWhere        bool
+	SupportsReturning       bool
+	SupportsRightJoin       bool
+	SupportsSample          bool
+	SupportsUpsert          bool
 }
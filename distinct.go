@@ -0,0 +1,35 @@
+package sequel
+
+func (b *builder) Distinct() QueryBuilder {
+	b.distinct = true
+	return b
+}
+
+func (b *builder) DistinctOn(columns ...string) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsDistinctOn {
+		b.addErr(unsupportedFeature("DISTINCT ON"))
+		return b
+	}
+
+	if len(columns) == 0 {
+		b.addErr(ErrEmptyColumn)
+		return b
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	b.distinct = true
+	b.distinctOn = append([]string(nil), columns...)
+
+	return b
+}
@@ -0,0 +1,89 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Distinct(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := &builder{}
+
+	// Act
+	result := b.Distinct()
+
+	// Assert
+	assert.NoError(t, b.err, "expected no error")
+	assert.True(t, b.distinct, "expected distinct to be enabled")
+	assert.Empty(t, b.distinctOn, "expected no DISTINCT ON columns")
+	assert.Equal(t, b, result, "expected Distinct() to return the same builder instance")
+}
+
+func TestBuilder_DistinctOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		dialect            Dialect
+		columns            []string
+		expectedDistinct   bool
+		expectedDistinctOn []string
+		expectedError      error
+	}{
+		{
+			name:               "should set DISTINCT ON columns",
+			dialect:            PostgresDialect{},
+			columns:            []string{"user_id", "created_at"},
+			expectedDistinct:   true,
+			expectedDistinctOn: []string{"user_id", "created_at"},
+		},
+		{
+			name:               "should set DISTINCT ON without dialect",
+			columns:            []string{"user_id"},
+			expectedDistinct:   true,
+			expectedDistinctOn: []string{"user_id"},
+		},
+		{
+			name:          "should return error when no columns are given",
+			columns:       []string{},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when a column is empty",
+			columns:       []string{"user_id", ""},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when dialect does not support DISTINCT ON",
+			dialect:       limitedDialect{},
+			columns:       []string{"user_id"},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.DistinctOn(tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedDistinct, b.distinct, "expected distinct flag to match")
+			assert.Equal(t, tt.expectedDistinctOn, b.distinctOn, "expected DISTINCT ON columns to match")
+			assert.Equal(t, b, result, "expected DistinctOn() to return the same builder instance")
+		})
+	}
+}
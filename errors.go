@@ -15,4 +15,16 @@ var (
 	ErrTypeMismatch         = errors.New("type mismatch")
 	ErrInvalidTableInput    = errors.New("invalid table input")
 	ErrInvalidJoinCondition = errors.New("invalid join condition")
+	ErrEmptyValues          = errors.New("empty values")
+	ErrColumnMismatch       = errors.New("values do not match columns")
+	ErrUnfilteredUpdate     = errors.New("update without where clause")
+	ErrUnfilteredDelete     = errors.New("delete without where clause")
+	ErrUnsupportedFeature   = errors.New("feature not supported by dialect")
+	ErrInvalidConflict      = errors.New("invalid on conflict clause")
+	ErrInvalidDistinctOn    = errors.New("distinct on columns must lead the order by")
+	ErrInvalidSample        = errors.New("sample ratio must be positive")
+	ErrArgCountMismatch     = errors.New("placeholder count does not match args")
+	ErrMissingNamedArg      = errors.New("missing value for named placeholder")
+	ErrInvalidOperator      = errors.New("invalid operator")
+	ErrInvalidIdentifier    = errors.New("invalid identifier")
 )
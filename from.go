@@ -6,6 +6,11 @@ func (b *builder) From(tbl string) QueryBuilder {
 		return b
 	}
 
+	if !b.validTable(tbl) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
 	b.table = table{
 		queryType: QueryBasic,
 		name:      tbl,
@@ -20,6 +25,11 @@ func (b *builder) FromRaw(expr string, args ...any) QueryBuilder {
 		return b
 	}
 
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
 	b.table = table{
 		queryType: QueryRaw,
 		expr:      expr,
@@ -50,6 +60,11 @@ func (b *builder) FromSub(fn func(QueryBuilder), alias string) QueryBuilder {
 		return b
 	}
 
+	if !b.validAlias(alias) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
 	subBuilder := New(b.dialect).(*builder)
 	fn(subBuilder)
 
@@ -67,3 +82,30 @@ func (b *builder) FromSub(fn func(QueryBuilder), alias string) QueryBuilder {
 
 	return b
 }
+
+func (b *builder) Final() QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsFinal {
+		b.addErr(unsupportedFeature("FINAL"))
+		return b
+	}
+
+	b.final = true
+
+	return b
+}
+
+func (b *builder) Sample(ratio float64) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsSample {
+		b.addErr(unsupportedFeature("SAMPLE"))
+		return b
+	}
+
+	if ratio <= 0 {
+		b.addErr(ErrInvalidSample)
+		return b
+	}
+
+	b.sample = ratio
+
+	return b
+}
@@ -329,6 +329,111 @@ func TestBuilder_FromSub(t *testing.T) {
 	}
 }
 
+func TestBuilder_Final(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       Dialect
+		expectedFinal bool
+		expectedError error
+	}{
+		{
+			name:          "should mark the table as FINAL",
+			expectedFinal: true,
+		},
+		{
+			name:          "should return error when dialect does not support FINAL",
+			dialect:       limitedDialect{},
+			expectedFinal: false,
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.Final()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedFinal, b.final, "expected final to be set correctly")
+			assert.Equal(t, b, result, "expected Final() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_Sample(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		dialect        Dialect
+		ratio          float64
+		expectedSample float64
+		expectedError  error
+	}{
+		{
+			name:           "should set a relative sample",
+			ratio:          0.1,
+			expectedSample: 0.1,
+		},
+		{
+			name:           "should set an absolute row count sample",
+			ratio:          10000,
+			expectedSample: 10000,
+		},
+		{
+			name:          "should return error for a zero ratio",
+			ratio:         0,
+			expectedError: ErrInvalidSample,
+		},
+		{
+			name:          "should return error for a negative ratio",
+			ratio:         -0.5,
+			expectedError: ErrInvalidSample,
+		},
+		{
+			name:          "should return error when dialect does not support SAMPLE",
+			dialect:       limitedDialect{},
+			ratio:         0.1,
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.Sample(tt.ratio)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedSample, b.sample, "expected sample to be set correctly")
+			assert.Equal(t, b, result, "expected Sample() to return the same builder instance")
+		})
+	}
+}
+
 // -----------------
 // --- BENCHMARK ---
 // -----------------
@@ -376,3 +481,20 @@ func BenchmarkBuilder_FromSub(b *testing.B) {
 		}, "active_users")
 	}
 }
+
+func BenchmarkBuilder_Final(b *testing.B) {
+	builder := &builder{}
+
+	for b.Loop() {
+		builder.Final()
+	}
+}
+
+func BenchmarkBuilder_Sample(b *testing.B) {
+	builder := &builder{}
+	ratio := 0.1
+
+	for b.Loop() {
+		builder.Sample(ratio)
+	}
+}
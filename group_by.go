@@ -0,0 +1,44 @@
+package sequel
+
+func (b *builder) GroupBy(columns ...string) QueryBuilder {
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	for _, col := range columns {
+		b.groupBys = append(b.groupBys, groupBy{
+			queryType: QueryBasic,
+			column:    col,
+		})
+	}
+
+	return b
+}
+
+func (b *builder) GroupByRaw(expr string, args ...any) QueryBuilder {
+	if expr == "" {
+		b.addErr(ErrEmptyExpression)
+		return b
+	}
+
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
+	b.groupBys = append(b.groupBys, groupBy{
+		queryType: QueryRaw,
+		expr:      expr,
+		args:      args,
+	})
+
+	return b
+}
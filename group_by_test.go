@@ -0,0 +1,139 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_GroupBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initialGroupBys  []groupBy
+		columns          []string
+		expectedGroupBys []groupBy
+		expectedError    error
+	}{
+		{
+			name:    "should add a single GROUP BY column",
+			columns: []string{"status"},
+			expectedGroupBys: []groupBy{
+				{queryType: QueryBasic, column: "status"},
+			},
+		},
+		{
+			name:    "should add multiple GROUP BY columns",
+			columns: []string{"country", "city"},
+			expectedGroupBys: []groupBy{
+				{queryType: QueryBasic, column: "country"},
+				{queryType: QueryBasic, column: "city"},
+			},
+		},
+		{
+			name: "should append to existing GROUP BY columns",
+			initialGroupBys: []groupBy{
+				{queryType: QueryBasic, column: "country"},
+			},
+			columns: []string{"users.city"},
+			expectedGroupBys: []groupBy{
+				{queryType: QueryBasic, column: "country"},
+				{queryType: QueryBasic, column: "users.city"},
+			},
+		},
+		{
+			name:          "should return error when a column is empty",
+			columns:       []string{"country", ""},
+			expectedError: ErrEmptyColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{groupBys: tt.initialGroupBys}
+
+			// Act
+			result := b.GroupBy(tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedGroupBys, b.groupBys, "expected GROUP BY to be updated correctly")
+			assert.Equal(t, b, result, "expected GroupBy() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_GroupByRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		expr             string
+		args             []any
+		expectedGroupBys []groupBy
+		expectedError    error
+	}{
+		{
+			name: "should add raw GROUP BY expression",
+			expr: "DATE(created_at)",
+			expectedGroupBys: []groupBy{
+				{queryType: QueryRaw, expr: "DATE(created_at)"},
+			},
+		},
+		{
+			name: "should add raw GROUP BY expression with args",
+			expr: "date_trunc(?, created_at)",
+			args: []any{"month"},
+			expectedGroupBys: []groupBy{
+				{queryType: QueryRaw, expr: "date_trunc(?, created_at)", args: []any{"month"}},
+			},
+		},
+		{
+			name:          "should return error when expression is empty",
+			expr:          "",
+			expectedError: ErrEmptyExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{}
+
+			// Act
+			result := b.GroupByRaw(tt.expr, tt.args...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedGroupBys, b.groupBys, "expected GROUP BY to be updated correctly")
+			assert.Equal(t, b, result, "expected GroupByRaw() to return the same builder instance")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkBuilder_GroupBy(b *testing.B) {
+	for b.Loop() {
+		builder := &builder{}
+		builder.GroupBy("country", "city")
+	}
+}
@@ -0,0 +1,69 @@
+package sequel
+
+func (b *builder) Having(column string, operator string, values ...any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhere("AND", column, operator, values...) })
+	return b
+}
+
+func (b *builder) OrHaving(column string, operator string, values ...any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhere("OR", column, operator, values...) })
+	return b
+}
+
+func (b *builder) HavingBetween(column string, from, to any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereBetween("AND", column, "BETWEEN", from, to) })
+	return b
+}
+
+func (b *builder) OrHavingBetween(column string, from, to any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereBetween("OR", column, "BETWEEN", from, to) })
+	return b
+}
+
+func (b *builder) HavingIn(column string, values ...any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereIn("AND", column, "IN", values...) })
+	return b
+}
+
+func (b *builder) OrHavingIn(column string, values ...any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereIn("OR", column, "IN", values...) })
+	return b
+}
+
+func (b *builder) HavingRaw(expr string, args ...any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereRaw("AND", expr, args...) })
+	return b
+}
+
+func (b *builder) OrHavingRaw(expr string, args ...any) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereRaw("OR", expr, args...) })
+	return b
+}
+
+func (b *builder) HavingGroup(fn func(QueryBuilder)) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereGroup("AND", fn) })
+	return b
+}
+
+func (b *builder) OrHavingGroup(fn func(QueryBuilder)) QueryBuilder {
+	b.addHaving(func(hb *builder) { hb.addWhereGroup("OR", fn) })
+	return b
+}
+
+// addHaving builds the condition with the WHERE helpers on a scratch builder
+// so HAVING shares their validation and condition tree. Unlike in WHERE, a
+// column may be an aggregate call such as COUNT(*) or SUM(total), which is
+// written unquoted with its argument quoted.
+func (b *builder) addHaving(fn func(*builder)) {
+	havingBuilder := New(b.dialect).(*builder)
+	havingBuilder.inHaving = true
+	fn(havingBuilder)
+
+	// propagate child error
+	if havingBuilder.err != nil {
+		b.addErr(havingBuilder.err)
+		return
+	}
+
+	b.havings = append(b.havings, havingBuilder.wheres...)
+}
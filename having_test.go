@@ -0,0 +1,161 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Having(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		initialHavings  []where
+		build           func(*builder) QueryBuilder
+		expectedHavings []where
+		expectedError   error
+	}{
+		{
+			name: "should add a basic HAVING condition",
+			build: func(b *builder) QueryBuilder {
+				return b.Having("COUNT(*)", ">", 5)
+			},
+			expectedHavings: []where{
+				{queryType: QueryBasic, conj: "AND", column: "COUNT(*)", operator: ">", args: []any{5}},
+			},
+		},
+		{
+			name: "should add an OR HAVING condition after existing ones",
+			initialHavings: []where{
+				{queryType: QueryBasic, conj: "AND", column: "total", operator: ">", args: []any{100}},
+			},
+			build: func(b *builder) QueryBuilder {
+				return b.OrHaving("total", "<", 10)
+			},
+			expectedHavings: []where{
+				{queryType: QueryBasic, conj: "AND", column: "total", operator: ">", args: []any{100}},
+				{queryType: QueryBasic, conj: "OR", column: "total", operator: "<", args: []any{10}},
+			},
+		},
+		{
+			name: "should route operators like Where does",
+			build: func(b *builder) QueryBuilder {
+				return b.Having("status", "IN", "a", "b")
+			},
+			expectedHavings: []where{
+				{queryType: QueryIn, conj: "AND", column: "status", operator: "IN", args: []any{"a", "b"}},
+			},
+		},
+		{
+			name: "should add HAVING BETWEEN and OR HAVING BETWEEN",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingBetween("total", 10, 20).OrHavingBetween("avg", 1, 2)
+			},
+			expectedHavings: []where{
+				{queryType: QueryBetween, conj: "AND", column: "total", operator: "BETWEEN", args: []any{10, 20}},
+				{queryType: QueryBetween, conj: "OR", column: "avg", operator: "BETWEEN", args: []any{1, 2}},
+			},
+		},
+		{
+			name: "should add HAVING IN and OR HAVING IN with flattened values",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingIn("country", []string{"DE", "FR"}).OrHavingIn("city", "Rome")
+			},
+			expectedHavings: []where{
+				{queryType: QueryIn, conj: "AND", column: "country", operator: "IN", args: []any{"DE", "FR"}},
+				{queryType: QueryIn, conj: "OR", column: "city", operator: "IN", args: []any{"Rome"}},
+			},
+		},
+		{
+			name: "should add HAVING RAW and OR HAVING RAW",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingRaw("SUM(amount) > ?", 100).OrHavingRaw("COUNT(*) = 0")
+			},
+			expectedHavings: []where{
+				{queryType: QueryRaw, conj: "AND", expr: "SUM(amount) > ?", args: []any{100}},
+				{queryType: QueryRaw, conj: "OR", expr: "COUNT(*) = 0"},
+			},
+		},
+		{
+			name: "should add HAVING groups",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					HavingGroup(func(q QueryBuilder) {
+						q.Where("COUNT(*)", ">", 1).OrWhere("SUM(total)", ">", 50)
+					}).
+					OrHavingGroup(func(q QueryBuilder) {
+						q.WhereRaw("MAX(total) > ?", 10)
+					})
+			},
+			expectedHavings: []where{
+				{queryType: QueryNested, conj: "AND", nested: []where{
+					{queryType: QueryBasic, conj: "AND", column: "COUNT(*)", operator: ">", args: []any{1}},
+					{queryType: QueryBasic, conj: "OR", column: "SUM(total)", operator: ">", args: []any{50}},
+				}},
+				{queryType: QueryNested, conj: "OR", nested: []where{
+					{queryType: QueryRaw, conj: "AND", expr: "MAX(total) > ?", args: []any{10}},
+				}},
+			},
+		},
+		{
+			name: "should not touch WHERE conditions",
+			build: func(b *builder) QueryBuilder {
+				return b.Where("active", "=", true).Having("COUNT(*)", ">", 1)
+			},
+			expectedHavings: []where{
+				{queryType: QueryBasic, conj: "AND", column: "COUNT(*)", operator: ">", args: []any{1}},
+			},
+		},
+		{
+			name: "should return error when HAVING BETWEEN column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingBetween("", 1, 2)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when HAVING IN column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingIn("", 1)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when HAVING RAW expression is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingRaw("")
+			},
+			expectedError: ErrEmptyExpression,
+		},
+		{
+			name: "should return error when HAVING group function is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.HavingGroup(nil)
+			},
+			expectedError: ErrNilFunc,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{havings: tt.initialHavings}
+
+			// Act
+			result := tt.build(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedHavings, b.havings, "expected HAVING to be updated correctly")
+			assert.Equal(t, b, result, "expected method to return the same builder instance")
+		})
+	}
+}
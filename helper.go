@@ -1,6 +1,7 @@
 package sequel
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -14,6 +15,12 @@ func (b *builder) addErr(err error) {
 	}
 }
 
+// unsupportedFeature wraps ErrUnsupportedFeature with the name of the SQL
+// feature the active dialect cannot compile.
+func unsupportedFeature(feature string) error {
+	return fmt.Errorf("%w: %s", ErrUnsupportedFeature, feature)
+}
+
 func flattenArgs(values []any) ([]any, error) {
 	if values == nil {
 		return []any{}, nil
@@ -130,3 +130,32 @@ func TestBuilder_flattenArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestBuilder_unsupportedFeature(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		feature         string
+		expectedMessage string
+	}{
+		{
+			name:            "should wrap ErrUnsupportedFeature with the feature name",
+			feature:         "RETURNING",
+			expectedMessage: "feature not supported by dialect: RETURNING",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := unsupportedFeature(tt.feature)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrUnsupportedFeature)
+			assert.EqualError(t, err, tt.expectedMessage)
+		})
+	}
+}
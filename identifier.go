@@ -0,0 +1,211 @@
+package sequel
+
+import (
+	"regexp"
+	"strings"
+)
+
+// StrictIdentifierPattern accepts plain unquoted names only. Assign it to the
+// IdentifierPattern of a dialect to reject anything else at build time.
+var StrictIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// aggregatePattern matches an aggregate call on a single column or *, such as
+// COUNT(*), SUM(total) or COUNT(DISTINCT user_id).
+var aggregatePattern = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\((?i:(DISTINCT) +)?([^()\s]+)\)$`)
+
+// validIdentifier reports whether every dot-separated part of id is non-empty
+// and, when pattern is not nil, matches it. "*" is only allowed as the last
+// part, as in "*" or "users.*".
+func validIdentifier(id string, pattern *regexp.Regexp) bool {
+	parts := strings.Split(id, ".")
+	for i, p := range parts {
+		if p == "*" {
+			if i != len(parts)-1 {
+				return false
+			}
+			continue
+		}
+
+		if p == "" {
+			return false
+		}
+
+		if pattern != nil && !pattern.MatchString(p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// quoteIdentifier wraps every dot-separated part of id with quoteIdentifierPart.
+// A "*" part is written bare so "users.*" still selects every column.
+func quoteIdentifier(id string, open, close byte) string {
+	var sb strings.Builder
+	parts := strings.Split(id, ".")
+	for i, p := range parts {
+		if i > 0 {
+			sb.WriteString(".")
+		}
+		if p == "*" {
+			sb.WriteString(p)
+			continue
+		}
+		sb.WriteString(quoteIdentifierPart(p, open, close))
+	}
+
+	return sb.String()
+}
+
+// quoteIdentifierPart wraps p in open and close, doubling every close quote
+// inside it so the name cannot end the quoted section early.
+func quoteIdentifierPart(p string, open, close byte) string {
+	var sb strings.Builder
+	sb.Grow(len(p) + 2)
+	sb.WriteByte(open)
+	for i := 0; i < len(p); i++ {
+		if p[i] == close {
+			sb.WriteByte(close)
+		}
+		sb.WriteByte(p[i])
+	}
+	sb.WriteByte(close)
+
+	return sb.String()
+}
+
+// splitAggregate splits an aggregate call into the part before the column,
+// such as "COUNT(" or "COUNT(DISTINCT ", and the column itself.
+func splitAggregate(expr string) (prefix, arg string, ok bool) {
+	m := aggregatePattern.FindStringSubmatch(expr)
+	if m == nil {
+		return "", "", false
+	}
+
+	prefix = m[1] + "("
+	if m[2] != "" {
+		prefix += "DISTINCT "
+	}
+
+	return prefix, m[3], true
+}
+
+func (b *builder) validIdentifier(id string) bool {
+	if b.dialect == nil {
+		return validIdentifier(id, nil)
+	}
+
+	return b.dialect.ValidIdentifier(id)
+}
+
+// validColumn validates a column the way WrapColumn splits it, so both sides
+// of "column AS alias" are checked.
+func (b *builder) validColumn(expr string) bool {
+	// HAVING conditions may compare aggregate calls, whose argument is checked
+	if b.inHaving {
+		if _, arg, ok := splitAggregate(expr); ok {
+			return b.validIdentifier(arg)
+		}
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) == 3 && strings.EqualFold(parts[1], "as") {
+		return b.validIdentifier(parts[0]) && b.validAlias(parts[2])
+	}
+
+	return b.validIdentifier(expr)
+}
+
+// validTable validates a table the way WrapTable splits it, so both sides of
+// "table alias" are checked.
+func (b *builder) validTable(expr string) bool {
+	parts := strings.Fields(expr)
+	if len(parts) == 2 {
+		return b.validIdentifier(parts[0]) && b.validAlias(parts[1])
+	}
+
+	return b.validIdentifier(expr)
+}
+
+func (b *builder) validAlias(alias string) bool {
+	return !strings.ContainsAny(alias, ".*") && b.validIdentifier(alias)
+}
+
+// quoter implements WrapColumn, WrapIdentifier and WrapTable for a pair of
+// quote characters.
+type quoter struct {
+	open, close byte
+
+	// upperCase folds all-lowercase parts to upper case, for Oracle which
+	// folds unquoted names the same way.
+	upperCase bool
+
+	// omitTableAs writes a table alias without AS, which Oracle rejects.
+	omitTableAs bool
+}
+
+var (
+	doubleQuotes = quoter{open: '"', close: '"'}
+	backticks    = quoter{open: '`', close: '`'}
+	brackets     = quoter{open: '[', close: ']'}
+)
+
+func (q quoter) identifier(id string) string {
+	if id == "" {
+		return ""
+	}
+
+	if q.upperCase {
+		parts := strings.Split(id, ".")
+		for i, p := range parts {
+			parts[i] = q.fold(p)
+		}
+		id = strings.Join(parts, ".")
+	}
+
+	return quoteIdentifier(id, q.open, q.close)
+}
+
+func (q quoter) alias(alias string) string {
+	return quoteIdentifierPart(q.fold(alias), q.open, q.close)
+}
+
+// column quotes "col" or "col AS alias".
+func (q quoter) column(expr string) string {
+	if expr == "" {
+		return ""
+	}
+
+	parts := strings.Fields(expr) // preserve original case but split cleanly
+	if len(parts) == 3 && strings.EqualFold(parts[1], "as") {
+		return q.identifier(parts[0]) + " AS " + q.alias(parts[2])
+	}
+
+	return q.identifier(expr)
+}
+
+// table quotes "table" or "table alias".
+func (q quoter) table(expr string) string {
+	if expr == "" {
+		return ""
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) == 2 {
+		if q.omitTableAs {
+			return q.identifier(parts[0]) + " " + q.alias(parts[1])
+		}
+
+		return q.identifier(parts[0]) + " AS " + q.alias(parts[1])
+	}
+
+	return q.identifier(expr)
+}
+
+func (q quoter) fold(p string) string {
+	if q.upperCase && p == strings.ToLower(p) {
+		return strings.ToUpper(p)
+	}
+
+	return p
+}
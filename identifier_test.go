@@ -0,0 +1,417 @@
+package sequel
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		pattern  *regexp.Regexp
+		expected bool
+	}{
+		{
+			name:     "should accept a single name",
+			input:    "users",
+			expected: true,
+		},
+		{
+			name:     "should accept a qualified name",
+			input:    "public.users.id",
+			expected: true,
+		},
+		{
+			name:     "should accept a bare star",
+			input:    "*",
+			expected: true,
+		},
+		{
+			name:     "should accept a qualified star",
+			input:    "users.*",
+			expected: true,
+		},
+		{
+			name:     "should reject an empty identifier",
+			input:    "",
+			expected: false,
+		},
+		{
+			name:     "should reject a trailing dot",
+			input:    "users.",
+			expected: false,
+		},
+		{
+			name:     "should reject an empty part",
+			input:    "users..id",
+			expected: false,
+		},
+		{
+			name:     "should reject a star that is not last",
+			input:    "*.id",
+			expected: false,
+		},
+		{
+			name:     "should accept quotes without a pattern",
+			input:    `id"; DROP TABLE users; --`,
+			expected: true,
+		},
+		{
+			name:     "should reject quotes with the strict pattern",
+			input:    `id"; DROP TABLE users; --`,
+			pattern:  StrictIdentifierPattern,
+			expected: false,
+		},
+		{
+			name:     "should check every part against the pattern",
+			input:    "users.user-id",
+			pattern:  StrictIdentifierPattern,
+			expected: false,
+		},
+		{
+			name:     "should not check a star against the pattern",
+			input:    "users.*",
+			pattern:  StrictIdentifierPattern,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result := validIdentifier(tt.input, tt.pattern)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected identifier validity to match")
+		})
+	}
+}
+
+func TestDialect_ValidIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		input    string
+		expected bool
+	}{
+		{
+			name:     "should accept a quote without a pattern for postgres",
+			dialect:  PostgresDialect{},
+			input:    `user"name`,
+			expected: true,
+		},
+		{
+			name:     "should reject a quote with a pattern for postgres",
+			dialect:  PostgresDialect{IdentifierPattern: StrictIdentifierPattern},
+			input:    `user"name`,
+			expected: false,
+		},
+		{
+			name:     "should accept a backtick without a pattern for mysql",
+			dialect:  MySQLDialect{},
+			input:    "user`name",
+			expected: true,
+		},
+		{
+			name:     "should reject a backtick with a pattern for mysql",
+			dialect:  MySQLDialect{IdentifierPattern: StrictIdentifierPattern},
+			input:    "user`name",
+			expected: false,
+		},
+		{
+			name:     "should accept a quote without a pattern for sqlite",
+			dialect:  SQLiteDialect{},
+			input:    `user"name`,
+			expected: true,
+		},
+		{
+			name:     "should reject a quote with a pattern for sqlite",
+			dialect:  SQLiteDialect{IdentifierPattern: StrictIdentifierPattern},
+			input:    `user"name`,
+			expected: false,
+		},
+		{
+			name:     "should accept a bracket without a pattern for sqlserver",
+			dialect:  SQLServerDialect{},
+			input:    "user]name",
+			expected: true,
+		},
+		{
+			name:     "should reject a bracket with a pattern for sqlserver",
+			dialect:  SQLServerDialect{IdentifierPattern: StrictIdentifierPattern},
+			input:    "user]name",
+			expected: false,
+		},
+		{
+			name:     "should accept a quote without a pattern for oracle",
+			dialect:  OracleDialect{},
+			input:    `user"name`,
+			expected: true,
+		},
+		{
+			name:     "should reject a quote with a pattern for oracle",
+			dialect:  OracleDialect{IdentifierPattern: StrictIdentifierPattern},
+			input:    `user"name`,
+			expected: false,
+		},
+		{
+			name:     "should accept a backtick without a pattern for clickhouse",
+			dialect:  ClickHouseDialect{},
+			input:    "user`name",
+			expected: true,
+		},
+		{
+			name:     "should reject a backtick with a pattern for clickhouse",
+			dialect:  ClickHouseDialect{IdentifierPattern: StrictIdentifierPattern},
+			input:    "user`name",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result := tt.dialect.ValidIdentifier(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected identifier validity to match")
+		})
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		open     byte
+		close    byte
+		expected string
+	}{
+		{
+			name:     "should quote every part",
+			input:    "public.users",
+			open:     '"',
+			close:    '"',
+			expected: `"public"."users"`,
+		},
+		{
+			name:     "should double the closing quote",
+			input:    `a"b`,
+			open:     '"',
+			close:    '"',
+			expected: `"a""b"`,
+		},
+		{
+			name:     "should double only the closing bracket",
+			input:    "a[b]c",
+			open:     '[',
+			close:    ']',
+			expected: "[a[b]]c]",
+		},
+		{
+			name:     "should write a qualified star bare",
+			input:    "users.*",
+			open:     '`',
+			close:    '`',
+			expected: "`users`.*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result := quoteIdentifier(tt.input, tt.open, tt.close)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted identifier to match")
+		})
+	}
+}
+
+func TestBuilder_InvalidIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		fn            func(QueryBuilder)
+		expectedError error
+	}{
+		{
+			name: "should accept plain names and aliases",
+			fn: func(qb QueryBuilder) {
+				qb.Select("users.id AS user_id", "users.*").From("users u").Where("u.id", "=", 1)
+			},
+		},
+		{
+			name: "should reject an invalid select column",
+			fn: func(qb QueryBuilder) {
+				qb.Select(`id"; DROP TABLE users; --`).From("users")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid column alias",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id AS user.id").From("users")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid table alias",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id").From("users u-1")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should accept aggregate calls in HAVING",
+			fn: func(qb QueryBuilder) {
+				qb.Select("user_id").From("orders").GroupBy("user_id").
+					Having("COUNT(*)", ">", 1).
+					HavingGroup(func(q QueryBuilder) {
+						q.Where("COUNT(DISTINCT orders.product_id)", ">", 1)
+					})
+			},
+		},
+		{
+			name: "should reject an invalid aggregate argument in HAVING",
+			fn: func(qb QueryBuilder) {
+				qb.Select("user_id").From("orders").GroupBy("user_id").Having("SUM(amount-1)", ">", 1)
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an aggregate call in WHERE",
+			fn: func(qb QueryBuilder) {
+				qb.Select("user_id").From("orders").Where("COUNT(*)", ">", 1)
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid where column",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id").From("users").Where("id = 1 OR 1", "=", 1)
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid join column",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id").From("users").Join("orders", "users.id", "=", "orders.user-id")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid order by column",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id").From("users").OrderBy("id;", "ASC")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid update column",
+			fn: func(qb QueryBuilder) {
+				qb.Update("users").Set("name = 'x', role", "admin").Where("id", "=", 1)
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid from subquery alias",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id").FromSub(func(q QueryBuilder) {
+					q.Select("id").From("users")
+				}, `bad alias"x`)
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid select subquery alias",
+			fn: func(qb QueryBuilder) {
+				qb.SelectSub(func(q QueryBuilder) {
+					q.SelectRaw("COUNT(*)").From("orders")
+				}, "order count").From("users")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid added subquery alias",
+			fn: func(qb QueryBuilder) {
+				qb.Select("id").AddSelectSub(func(q QueryBuilder) {
+					q.SelectRaw("COUNT(*)").From("orders")
+				}, "orders.total").From("users")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid CTE name",
+			fn: func(qb QueryBuilder) {
+				qb.With("bad-name", func(q QueryBuilder) {
+					q.Select("id").From("users")
+				}).Select("id").From("users")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid CTE column",
+			fn: func(qb QueryBuilder) {
+				qb.WithColumns("active", []string{"id; --"}, func(q QueryBuilder) {
+					q.Select("id").From("users")
+				}).Select("id").From("active")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+		{
+			name: "should reject an invalid conflict constraint",
+			fn: func(qb QueryBuilder) {
+				qb.Insert("users").Columns("email").Values("a@b.c").OnConflictConstraint("users_email_key DO NOTHING; --")
+			},
+			expectedError: ErrInvalidIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			qb := New(PostgresDialect{IdentifierPattern: StrictIdentifierPattern})
+
+			// Act
+			tt.fn(qb)
+			err := qb.(*builder).err
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkQuoteIdentifier(b *testing.B) {
+	for b.Loop() {
+		quoteIdentifier("public.users.id", '"', '"')
+	}
+}
@@ -0,0 +1,173 @@
+package sequel
+
+import (
+	"sort"
+)
+
+func (b *builder) Insert(tbl string) QueryBuilder {
+	b.action = "insert"
+
+	if tbl == "" {
+		b.addErr(ErrEmptyTable)
+		return b
+	}
+
+	if !b.validTable(tbl) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
+	b.table = table{
+		queryType: QueryBasic,
+		name:      tbl,
+	}
+
+	return b
+}
+
+func (b *builder) Columns(columns ...string) QueryBuilder {
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validIdentifier(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	// rows added before the columns must still line up with them
+	for _, row := range b.values {
+		if len(row) != len(columns) {
+			b.addErr(ErrColumnMismatch)
+			return b
+		}
+	}
+
+	b.insertColumns = append([]string(nil), columns...)
+
+	return b
+}
+
+func (b *builder) Values(values ...any) QueryBuilder {
+	if len(values) == 0 {
+		b.addErr(ErrEmptyValues)
+		return b
+	}
+
+	// Values([]any{...}, []any{...}) adds one row per slice
+	rows := 0
+	for _, v := range values {
+		if _, ok := v.([]any); ok {
+			rows++
+		}
+	}
+
+	if rows == 0 {
+		b.addValues(values)
+		return b
+	}
+
+	if rows != len(values) {
+		b.addErr(ErrNestedSlice)
+		return b
+	}
+
+	for _, v := range values {
+		if !b.addValues(v.([]any)) {
+			return b
+		}
+	}
+
+	return b
+}
+
+// addValues appends one row, reporting whether it matched the columns.
+func (b *builder) addValues(row []any) bool {
+	if len(row) == 0 {
+		b.addErr(ErrEmptyValues)
+		return false
+	}
+
+	if len(b.insertColumns) > 0 && len(row) != len(b.insertColumns) {
+		b.addErr(ErrColumnMismatch)
+		return false
+	}
+
+	if len(b.values) > 0 && len(row) != len(b.values[0]) {
+		b.addErr(ErrColumnMismatch)
+		return false
+	}
+
+	for _, v := range row {
+		if err := b.checkValue(v); err != nil {
+			b.addErr(err)
+			return false
+		}
+	}
+
+	b.values = append(b.values, append([]any(nil), row...))
+
+	return true
+}
+
+func (b *builder) ValuesMap(rows ...map[string]any) QueryBuilder {
+	for _, row := range rows {
+		if len(row) == 0 {
+			b.addErr(ErrEmptyValues)
+			return b
+		}
+
+		// The first map defines the column list (sorted for a stable output)
+		// unless Columns() has already been called.
+		if len(b.insertColumns) == 0 {
+			if len(b.values) > 0 {
+				b.addErr(ErrColumnMismatch)
+				return b
+			}
+
+			columns := make([]string, 0, len(row))
+			for col := range row {
+				if col == "" {
+					b.addErr(ErrEmptyColumn)
+					return b
+				}
+
+				if !b.validIdentifier(col) {
+					b.addErr(ErrInvalidIdentifier)
+					return b
+				}
+				columns = append(columns, col)
+			}
+			sort.Strings(columns)
+
+			b.insertColumns = columns
+		}
+
+		if len(row) != len(b.insertColumns) {
+			b.addErr(ErrColumnMismatch)
+			return b
+		}
+
+		values := make([]any, len(b.insertColumns))
+		for i, col := range b.insertColumns {
+			v, ok := row[col]
+			if !ok {
+				b.addErr(ErrColumnMismatch)
+				return b
+			}
+
+			if err := b.checkValue(v); err != nil {
+				b.addErr(err)
+				return b
+			}
+			values[i] = v
+		}
+
+		b.values = append(b.values, values)
+	}
+
+	return b
+}
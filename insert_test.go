@@ -0,0 +1,359 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Insert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		table         string
+		expectedTable table
+		expectedError error
+	}{
+		{
+			name:  "should set action and table",
+			table: "users",
+			expectedTable: table{
+				queryType: QueryBasic,
+				name:      "users",
+			},
+		},
+		{
+			name:  "should set schema qualified table",
+			table: "public.users",
+			expectedTable: table{
+				queryType: QueryBasic,
+				name:      "public.users",
+			},
+		},
+		{
+			name:          "should return error when table is empty",
+			table:         "",
+			expectedTable: table{},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{}
+
+			// Act
+			result := b.Insert(tt.table)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, "insert", b.action, "expected action to be insert")
+			assert.Equal(t, tt.expectedTable, b.table, "expected table to be set correctly")
+			assert.Equal(t, b, result, "expected Insert() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_Columns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		initialValues   [][]any
+		columns         []string
+		expectedColumns []string
+		expectedError   error
+	}{
+		{
+			name:            "should set columns",
+			columns:         []string{"name", "email"},
+			expectedColumns: []string{"name", "email"},
+		},
+		{
+			name:            "should set a single column",
+			columns:         []string{"id"},
+			expectedColumns: []string{"id"},
+		},
+		{
+			name:            "should accept columns matching existing rows",
+			initialValues:   [][]any{{"John", "john@example.com"}},
+			columns:         []string{"name", "email"},
+			expectedColumns: []string{"name", "email"},
+		},
+		{
+			name:          "should return error when a column is empty",
+			columns:       []string{"name", ""},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when columns do not match existing rows",
+			initialValues: [][]any{{"John"}},
+			columns:       []string{"name", "email"},
+			expectedError: ErrColumnMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{values: tt.initialValues}
+
+			// Act
+			result := b.Columns(tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedColumns, b.insertColumns, "expected columns to be set correctly")
+			assert.Equal(t, b, result, "expected Columns() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_Values(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		initialColumns []string
+		initialValues  [][]any
+		values         []any
+		expectedValues [][]any
+		expectedError  error
+	}{
+		{
+			name:           "should add a single row",
+			initialColumns: []string{"name", "age"},
+			values:         []any{"John", 30},
+			expectedValues: [][]any{{"John", 30}},
+		},
+		{
+			name:           "should append another row",
+			initialColumns: []string{"name", "age"},
+			initialValues:  [][]any{{"John", 30}},
+			values:         []any{"Jane", 25},
+			expectedValues: [][]any{{"John", 30}, {"Jane", 25}},
+		},
+		{
+			name:           "should add a row without columns",
+			values:         []any{1, "John"},
+			expectedValues: [][]any{{1, "John"}},
+		},
+		{
+			name:           "should allow nil values",
+			initialColumns: []string{"name", "deleted_at"},
+			values:         []any{"John", nil},
+			expectedValues: [][]any{{"John", nil}},
+		},
+		{
+			name:           "should add one row per []any argument",
+			initialColumns: []string{"id", "name"},
+			values:         []any{[]any{1, "a"}, []any{2, "b"}},
+			expectedValues: [][]any{{1, "a"}, {2, "b"}},
+		},
+		{
+			name:           "should keep other slices as single values",
+			initialColumns: []string{"id", "payload"},
+			values:         []any{1, []byte("raw")},
+			expectedValues: [][]any{{1, []byte("raw")}},
+		},
+		{
+			name:           "should keep column references and raw expressions",
+			initialColumns: []string{"name", "created_at", "updated_at"},
+			values:         []any{"John", Raw("NOW()"), Col("created_at")},
+			expectedValues: [][]any{{"John", Raw("NOW()"), Col("created_at")}},
+		},
+		{
+			name:           "should return error when a raw expression is empty",
+			initialColumns: []string{"name", "created_at"},
+			values:         []any{"John", Raw("")},
+			expectedError:  ErrEmptyExpression,
+		},
+		{
+			name:           "should return error when rows are mixed with values",
+			initialColumns: []string{"id", "name"},
+			values:         []any{[]any{1, "a"}, "b"},
+			expectedError:  ErrNestedSlice,
+		},
+		{
+			name:           "should return error when a row does not match columns",
+			initialColumns: []string{"id", "name"},
+			values:         []any{[]any{1, "a"}, []any{2}},
+			expectedValues: [][]any{{1, "a"}},
+			expectedError:  ErrColumnMismatch,
+		},
+		{
+			name:           "should return error when values are empty",
+			initialColumns: []string{"name"},
+			values:         []any{},
+			expectedError:  ErrEmptyValues,
+		},
+		{
+			name:           "should return error when row length does not match columns",
+			initialColumns: []string{"name", "age"},
+			values:         []any{"John"},
+			expectedError:  ErrColumnMismatch,
+		},
+		{
+			name:           "should return error when row length does not match previous rows",
+			initialValues:  [][]any{{"John", 30}},
+			values:         []any{"Jane"},
+			expectedValues: [][]any{{"John", 30}},
+			expectedError:  ErrColumnMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{insertColumns: tt.initialColumns, values: tt.initialValues}
+
+			// Act
+			result := b.Values(tt.values...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedValues, b.values, "expected values to be set correctly")
+			assert.Equal(t, b, result, "expected Values() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_ValuesMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		initialColumns  []string
+		initialValues   [][]any
+		rows            []map[string]any
+		expectedColumns []string
+		expectedValues  [][]any
+		expectedError   error
+	}{
+		{
+			name:            "should derive sorted columns from the first map",
+			rows:            []map[string]any{{"name": "John", "age": 30}},
+			expectedColumns: []string{"age", "name"},
+			expectedValues:  [][]any{{30, "John"}},
+		},
+		{
+			name: "should add multiple rows in column order",
+			rows: []map[string]any{
+				{"name": "John", "age": 30},
+				{"age": 25, "name": "Jane"},
+			},
+			expectedColumns: []string{"age", "name"},
+			expectedValues:  [][]any{{30, "John"}, {25, "Jane"}},
+		},
+		{
+			name:            "should follow columns set by Columns()",
+			initialColumns:  []string{"name", "age"},
+			rows:            []map[string]any{{"age": 30, "name": "John"}},
+			expectedColumns: []string{"name", "age"},
+			expectedValues:  [][]any{{"John", 30}},
+		},
+		{
+			name:          "should return error when a row is empty",
+			rows:          []map[string]any{{}},
+			expectedError: ErrEmptyValues,
+		},
+		{
+			name:          "should return error when a key is empty",
+			rows:          []map[string]any{{"": 1}},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when rows have different columns",
+			rows: []map[string]any{
+				{"name": "John", "age": 30},
+				{"name": "Jane", "email": "jane@example.com"},
+			},
+			expectedColumns: []string{"age", "name"},
+			expectedValues:  [][]any{{30, "John"}},
+			expectedError:   ErrColumnMismatch,
+		},
+		{
+			name: "should return error when rows have a different number of columns",
+			rows: []map[string]any{
+				{"name": "John"},
+				{"name": "Jane", "age": 25},
+			},
+			expectedColumns: []string{"name"},
+			expectedValues:  [][]any{{"John"}},
+			expectedError:   ErrColumnMismatch,
+		},
+		{
+			name:           "should return error when positional rows exist without columns",
+			initialValues:  [][]any{{"John"}},
+			rows:           []map[string]any{{"name": "Jane"}},
+			expectedValues: [][]any{{"John"}},
+			expectedError:  ErrColumnMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{insertColumns: tt.initialColumns, values: tt.initialValues}
+
+			// Act
+			result := b.ValuesMap(tt.rows...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedColumns, b.insertColumns, "expected columns to be set correctly")
+			assert.Equal(t, tt.expectedValues, b.values, "expected values to be set correctly")
+			assert.Equal(t, b, result, "expected ValuesMap() to return the same builder instance")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkBuilder_Values(b *testing.B) {
+	for b.Loop() {
+		builder := &builder{}
+		builder.Insert("users").Columns("name", "email").Values("John", "john@example.com")
+	}
+}
+
+func BenchmarkBuilder_ValuesMap(b *testing.B) {
+	row := map[string]any{"name": "John", "email": "john@example.com"}
+
+	for b.Loop() {
+		builder := &builder{}
+		builder.Insert("users").ValuesMap(row)
+	}
+}
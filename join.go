@@ -11,21 +11,169 @@ func (b *builder) LeftJoin(table, leftCol, operator, rightCol string) QueryBuild
 }
 
 func (b *builder) RightJoin(table, leftCol, operator, rightCol string) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsRightJoin {
+		b.addErr(unsupportedFeature("RIGHT JOIN"))
+		return b
+	}
+
 	b.addJoin("RIGHT JOIN", table, leftCol, operator, rightCol)
 	return b
 }
 
+func (b *builder) FullJoin(table, leftCol, operator, rightCol string) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsFullJoin {
+		b.addErr(unsupportedFeature("FULL JOIN"))
+		return b
+	}
+
+	b.addJoin("FULL JOIN", table, leftCol, operator, rightCol)
+	return b
+}
+
+func (b *builder) CrossJoin(table string) QueryBuilder {
+	b.addJoinTable("CROSS JOIN", table)
+	return b
+}
+
+func (b *builder) NaturalJoin(table string) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsNaturalJoin {
+		b.addErr(unsupportedFeature("NATURAL JOIN"))
+		return b
+	}
+
+	b.addJoinTable("NATURAL JOIN", table)
+	return b
+}
+
+func (b *builder) JoinUsing(table string, columns ...string) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsJoinUsing {
+		b.addErr(unsupportedFeature("JOIN USING"))
+		return b
+	}
+
+	if len(columns) == 0 {
+		b.addErr(ErrInvalidJoinCondition)
+		return b
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validIdentifier(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	if !b.addJoinTable("INNER JOIN", table) {
+		return b
+	}
+
+	b.joins[len(b.joins)-1].using = append([]string(nil), columns...)
+
+	return b
+}
+
+func (b *builder) JoinLateral(fn func(QueryBuilder), alias string) QueryBuilder {
+	b.addJoinLateral("INNER JOIN", fn, alias)
+	return b
+}
+
+func (b *builder) LeftJoinLateral(fn func(QueryBuilder), alias string) QueryBuilder {
+	b.addJoinLateral("LEFT JOIN", fn, alias)
+	return b
+}
+
+func (b *builder) JoinSub(fn func(QueryBuilder), alias, leftCol, operator, rightCol string) QueryBuilder {
+	b.addJoinSub("INNER JOIN", fn, alias, leftCol, operator, rightCol)
+	return b
+}
+
+func (b *builder) LeftJoinSub(fn func(QueryBuilder), alias, leftCol, operator, rightCol string) QueryBuilder {
+	b.addJoinSub("LEFT JOIN", fn, alias, leftCol, operator, rightCol)
+	return b
+}
+
+func (b *builder) JoinRaw(expr string, args ...any) QueryBuilder {
+	if expr == "" {
+		b.addErr(ErrEmptyExpression)
+		return b
+	}
+
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
+	b.joins = append(b.joins, join{
+		queryType: QueryRaw,
+		expr:      expr,
+		args:      args,
+	})
+
+	return b
+}
+
+func (b *builder) JoinOn(table string, fn func(JoinClause)) QueryBuilder {
+	b.addJoinOn("INNER JOIN", table, fn)
+	return b
+}
+
+func (b *builder) LeftJoinOn(table string, fn func(JoinClause)) QueryBuilder {
+	b.addJoinOn("LEFT JOIN", table, fn)
+	return b
+}
+
+func (b *builder) RightJoinOn(table string, fn func(JoinClause)) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsRightJoin {
+		b.addErr(unsupportedFeature("RIGHT JOIN"))
+		return b
+	}
+
+	b.addJoinOn("RIGHT JOIN", table, fn)
+	return b
+}
+
+func (b *builder) ArrayJoin(columns ...string) QueryBuilder {
+	b.addArrayJoin("ARRAY JOIN", columns...)
+	return b
+}
+
+func (b *builder) LeftArrayJoin(columns ...string) QueryBuilder {
+	b.addArrayJoin("LEFT ARRAY JOIN", columns...)
+	return b
+}
+
 func (b *builder) addJoin(joinType, table, leftCol, operator, rightCol string) {
 	if table == "" {
 		b.addErr(ErrEmptyTable)
 		return
 	}
 
+	if !b.validTable(table) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
 	if leftCol == "" || operator == "" || rightCol == "" {
 		b.addErr(ErrInvalidJoinCondition)
 		return
 	}
 
+	if !b.validColumn(leftCol) || !b.validColumn(rightCol) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	operator = normalizeOperator(operator)
+	if !b.supportsOperator(operator) {
+		b.addErr(ErrInvalidOperator)
+		return
+	}
+
 	b.joins = append(b.joins, join{
 		queryType: QueryBasic,
 		joinType:  joinType,
@@ -35,3 +183,185 @@ func (b *builder) addJoin(joinType, table, leftCol, operator, rightCol string) {
 		rightCol:  rightCol,
 	})
 }
+
+// addJoinTable adds a join without an ON condition, reporting whether it
+// was added.
+func (b *builder) addJoinTable(joinType, table string) bool {
+	if table == "" {
+		b.addErr(ErrEmptyTable)
+		return false
+	}
+
+	if !b.validTable(table) {
+		b.addErr(ErrInvalidIdentifier)
+		return false
+	}
+
+	b.joins = append(b.joins, join{
+		queryType: QueryBasic,
+		joinType:  joinType,
+		table:     table,
+	})
+
+	return true
+}
+
+func (b *builder) addJoinLateral(joinType string, fn func(QueryBuilder), alias string) {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsLateral {
+		b.addErr(unsupportedFeature("LATERAL"))
+		return
+	}
+
+	if fn == nil {
+		b.addErr(ErrNilFunc)
+		return
+	}
+
+	if alias == "" {
+		b.addErr(ErrEmptyAlias)
+		return
+	}
+
+	if !b.validAlias(alias) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	subBuilder := New(b.dialect).(*builder)
+	subBuilder.action = "select"
+	fn(subBuilder)
+
+	// propagate child error
+	if subBuilder.err != nil {
+		b.addErr(subBuilder.err)
+		return
+	}
+
+	b.joins = append(b.joins, join{
+		queryType: QuerySub,
+		joinType:  joinType,
+		table:     alias,
+		lateral:   true,
+		sub:       subBuilder,
+	})
+}
+
+func (b *builder) addJoinSub(joinType string, fn func(QueryBuilder), alias, leftCol, operator, rightCol string) {
+	if fn == nil {
+		b.addErr(ErrNilFunc)
+		return
+	}
+
+	if alias == "" {
+		b.addErr(ErrEmptyAlias)
+		return
+	}
+
+	if !b.validAlias(alias) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	if leftCol == "" || operator == "" || rightCol == "" {
+		b.addErr(ErrInvalidJoinCondition)
+		return
+	}
+
+	if !b.validColumn(leftCol) || !b.validColumn(rightCol) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	operator = normalizeOperator(operator)
+	if !b.supportsOperator(operator) {
+		b.addErr(ErrInvalidOperator)
+		return
+	}
+
+	subBuilder := New(b.dialect).(*builder)
+	subBuilder.action = "select"
+	fn(subBuilder)
+
+	// propagate child error
+	if subBuilder.err != nil {
+		b.addErr(subBuilder.err)
+		return
+	}
+
+	b.joins = append(b.joins, join{
+		queryType: QuerySub,
+		joinType:  joinType,
+		table:     alias,
+		leftCol:   leftCol,
+		operator:  operator,
+		rightCol:  rightCol,
+		sub:       subBuilder,
+	})
+}
+
+func (b *builder) addJoinOn(joinType, table string, fn func(JoinClause)) {
+	if table == "" {
+		b.addErr(ErrEmptyTable)
+		return
+	}
+
+	if !b.validTable(table) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	if fn == nil {
+		b.addErr(ErrNilFunc)
+		return
+	}
+
+	clause := newJoinClause(b.dialect)
+	fn(clause)
+
+	// propagate child error
+	if clause.b.err != nil {
+		b.addErr(clause.b.err)
+		return
+	}
+
+	if len(clause.b.wheres) == 0 {
+		b.addErr(ErrInvalidJoinCondition)
+		return
+	}
+
+	b.joins = append(b.joins, join{
+		queryType: QueryNested,
+		joinType:  joinType,
+		table:     table,
+		on:        clause.b.wheres,
+	})
+}
+
+func (b *builder) addArrayJoin(joinType string, columns ...string) {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsArrayJoin {
+		b.addErr(unsupportedFeature(joinType))
+		return
+	}
+
+	if len(columns) == 0 {
+		b.addErr(ErrEmptyColumn)
+		return
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return
+		}
+
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return
+		}
+	}
+
+	b.arrayJoins = append(b.arrayJoins, arrayJoin{
+		joinType: joinType,
+		columns:  append([]string(nil), columns...),
+	})
+}
@@ -0,0 +1,143 @@
+package sequel
+
+// JoinClause builds the ON condition of a join. On compares two columns,
+// the Where family compares a column with bound values.
+type JoinClause interface {
+	On(leftCol, operator, rightCol string) JoinClause
+	OrOn(leftCol, operator, rightCol string) JoinClause
+
+	Where(column string, operator string, values ...any) JoinClause
+	OrWhere(column string, operator string, values ...any) JoinClause
+
+	WhereIn(column string, values ...any) JoinClause
+	OrWhereIn(column string, values ...any) JoinClause
+	WhereNotIn(column string, values ...any) JoinClause
+	OrWhereNotIn(column string, values ...any) JoinClause
+
+	WhereNull(column string) JoinClause
+	OrWhereNull(column string) JoinClause
+	WhereNotNull(column string) JoinClause
+	OrWhereNotNull(column string) JoinClause
+
+	OnGroup(fn func(JoinClause)) JoinClause
+	OrOnGroup(fn func(JoinClause)) JoinClause
+}
+
+// joinClause collects conditions in the wheres of a scratch builder so the
+// WHERE validation and compiler are shared.
+type joinClause struct {
+	b *builder
+}
+
+func newJoinClause(d Dialect) *joinClause {
+	return &joinClause{b: New(d).(*builder)}
+}
+
+func (j *joinClause) On(leftCol, operator, rightCol string) JoinClause {
+	j.addOn("AND", leftCol, operator, rightCol)
+	return j
+}
+
+func (j *joinClause) OrOn(leftCol, operator, rightCol string) JoinClause {
+	j.addOn("OR", leftCol, operator, rightCol)
+	return j
+}
+
+func (j *joinClause) addOn(conj, leftCol, operator, rightCol string) {
+	if leftCol == "" || operator == "" || rightCol == "" {
+		j.b.addErr(ErrInvalidJoinCondition)
+		return
+	}
+
+	operator = normalizeOperator(operator)
+	if !j.b.supportsOperator(operator) {
+		j.b.addErr(ErrInvalidOperator)
+		return
+	}
+
+	j.b.addWhereColumn(conj, leftCol, operator, Col(rightCol))
+}
+
+func (j *joinClause) Where(column string, operator string, values ...any) JoinClause {
+	j.b.addWhere("AND", column, operator, values...)
+	return j
+}
+
+func (j *joinClause) OrWhere(column string, operator string, values ...any) JoinClause {
+	j.b.addWhere("OR", column, operator, values...)
+	return j
+}
+
+func (j *joinClause) WhereIn(column string, values ...any) JoinClause {
+	j.b.addWhereIn("AND", column, "IN", values...)
+	return j
+}
+
+func (j *joinClause) OrWhereIn(column string, values ...any) JoinClause {
+	j.b.addWhereIn("OR", column, "IN", values...)
+	return j
+}
+
+func (j *joinClause) WhereNotIn(column string, values ...any) JoinClause {
+	j.b.addWhereIn("AND", column, "NOT IN", values...)
+	return j
+}
+
+func (j *joinClause) OrWhereNotIn(column string, values ...any) JoinClause {
+	j.b.addWhereIn("OR", column, "NOT IN", values...)
+	return j
+}
+
+func (j *joinClause) WhereNull(column string) JoinClause {
+	j.b.addWhereNull("AND", column, "IS NULL")
+	return j
+}
+
+func (j *joinClause) OrWhereNull(column string) JoinClause {
+	j.b.addWhereNull("OR", column, "IS NULL")
+	return j
+}
+
+func (j *joinClause) WhereNotNull(column string) JoinClause {
+	j.b.addWhereNull("AND", column, "IS NOT NULL")
+	return j
+}
+
+func (j *joinClause) OrWhereNotNull(column string) JoinClause {
+	j.b.addWhereNull("OR", column, "IS NOT NULL")
+	return j
+}
+
+func (j *joinClause) OnGroup(fn func(JoinClause)) JoinClause {
+	j.addOnGroup("AND", fn)
+	return j
+}
+
+func (j *joinClause) OrOnGroup(fn func(JoinClause)) JoinClause {
+	j.addOnGroup("OR", fn)
+	return j
+}
+
+func (j *joinClause) addOnGroup(conj string, fn func(JoinClause)) {
+	if fn == nil {
+		j.b.addErr(ErrNilFunc)
+		return
+	}
+
+	nested := newJoinClause(j.b.dialect)
+	fn(nested)
+
+	// propagate child error
+	if nested.b.err != nil {
+		j.b.addErr(nested.b.err)
+		return
+	}
+
+	if len(nested.b.wheres) > 0 {
+		j.b.wheres = append(j.b.wheres, where{
+			queryType: QueryNested,
+			conj:      conj,
+			nested:    nested.b.wheres,
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinClause(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		build          func(JoinClause)
+		expectedWheres []where
+		expectedErr    error
+	}{
+		{
+			name: "should add a column to column condition",
+			build: func(jc JoinClause) {
+				jc.On("orders.user_id", "=", "users.id")
+			},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "orders.user_id", operator: "=", expr: "users.id"},
+			},
+		},
+		{
+			name: "should add composite key conditions",
+			build: func(jc JoinClause) {
+				jc.On("orders.user_id", "=", "users.id").OrOn("orders.tenant_id", "=", "users.tenant_id")
+			},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "orders.user_id", operator: "=", expr: "users.id"},
+				{queryType: QueryColumn, conj: "OR", column: "orders.tenant_id", operator: "=", expr: "users.tenant_id"},
+			},
+		},
+		{
+			name: "should add value conditions",
+			build: func(jc JoinClause) {
+				jc.
+					On("orders.user_id", "=", "users.id").
+					Where("orders.status", "=", "paid").
+					OrWhere("orders.total", ">", 100).
+					WhereIn("orders.region", "eu", "us").
+					OrWhereNotIn("orders.channel", "api").
+					WhereNull("orders.deleted_at").
+					OrWhereNotNull("orders.paid_at").
+					OrWhereNull("orders.refunded_at").
+					WhereNotNull("orders.shipped_at")
+			},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "orders.user_id", operator: "=", expr: "users.id"},
+				{queryType: QueryBasic, conj: "AND", column: "orders.status", operator: "=", args: []any{"paid"}},
+				{queryType: QueryBasic, conj: "OR", column: "orders.total", operator: ">", args: []any{100}},
+				{queryType: QueryIn, conj: "AND", column: "orders.region", operator: "IN", args: []any{"eu", "us"}},
+				{queryType: QueryIn, conj: "OR", column: "orders.channel", operator: "NOT IN", args: []any{"api"}},
+				{queryType: QueryNull, conj: "AND", column: "orders.deleted_at", operator: "IS NULL", args: []any{}},
+				{queryType: QueryNull, conj: "OR", column: "orders.paid_at", operator: "IS NOT NULL", args: []any{}},
+				{queryType: QueryNull, conj: "OR", column: "orders.refunded_at", operator: "IS NULL", args: []any{}},
+				{queryType: QueryNull, conj: "AND", column: "orders.shipped_at", operator: "IS NOT NULL", args: []any{}},
+			},
+		},
+		{
+			name: "should add nested groups",
+			build: func(jc JoinClause) {
+				jc.
+					On("orders.user_id", "=", "users.id").
+					OnGroup(func(g JoinClause) {
+						g.Where("orders.status", "=", "paid").OrWhere("orders.status", "=", "shipped")
+					}).
+					OrOnGroup(func(g JoinClause) {
+						g.WhereNull("orders.deleted_at")
+					})
+			},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "orders.user_id", operator: "=", expr: "users.id"},
+				{queryType: QueryNested, conj: "AND", nested: []where{
+					{queryType: QueryBasic, conj: "AND", column: "orders.status", operator: "=", args: []any{"paid"}},
+					{queryType: QueryBasic, conj: "OR", column: "orders.status", operator: "=", args: []any{"shipped"}},
+				}},
+				{queryType: QueryNested, conj: "OR", nested: []where{
+					{queryType: QueryNull, conj: "AND", column: "orders.deleted_at", operator: "IS NULL", args: []any{}},
+				}},
+			},
+		},
+		{
+			name: "should skip an empty group",
+			build: func(jc JoinClause) {
+				jc.On("orders.user_id", "=", "users.id").OnGroup(func(g JoinClause) {})
+			},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "orders.user_id", operator: "=", expr: "users.id"},
+			},
+		},
+		{
+			name: "should normalize the operator",
+			build: func(jc JoinClause) {
+				jc.On("orders.total", " >= ", "users.credit")
+			},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "orders.total", operator: ">=", expr: "users.credit"},
+			},
+		},
+		{
+			name: "should return an error for an incomplete On",
+			build: func(jc JoinClause) {
+				jc.On("orders.user_id", "=", "")
+			},
+			expectedErr: ErrInvalidJoinCondition,
+		},
+		{
+			name: "should return an error for an invalid On operator",
+			build: func(jc JoinClause) {
+				jc.On("orders.user_id", "= users.id OR 1 =", "users.id")
+			},
+			expectedErr: ErrInvalidOperator,
+		},
+		{
+			name: "should return an error for a nil group",
+			build: func(jc JoinClause) {
+				jc.OnGroup(nil)
+			},
+			expectedErr: ErrNilFunc,
+		},
+		{
+			name: "should propagate an error from a group",
+			build: func(jc JoinClause) {
+				jc.OrOnGroup(func(g JoinClause) {
+					g.WhereIn("")
+				})
+			},
+			expectedErr: ErrEmptyColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			jc := newJoinClause(PostgresDialect{})
+
+			// Act
+			tt.build(jc)
+
+			// Assert
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, jc.b.err, tt.expectedErr, "expected error to match")
+				return
+			}
+
+			assert.NoError(t, jc.b.err, "expected no error")
+			assert.Equal(t, tt.expectedWheres, jc.b.wheres, "expected join conditions to match")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkJoinClause(b *testing.B) {
+	for b.Loop() {
+		jc := newJoinClause(PostgresDialect{})
+		jc.On("orders.user_id", "=", "users.id").WhereNull("orders.deleted_at")
+	}
+}
@@ -75,6 +75,24 @@ func TestBuilder_Join(t *testing.T) {
 			rightCol:    "",
 			expectedErr: ErrInvalidJoinCondition,
 		},
+		{
+			name:        "should return an error if operator is not allowed",
+			table:       "orders",
+			leftCol:     "users.id",
+			operator:    "= orders.user_id OR 1",
+			rightCol:    "orders.user_id",
+			expectedErr: ErrInvalidOperator,
+		},
+		{
+			name:     "should normalize the operator",
+			table:    "orders",
+			leftCol:  "orders.total",
+			operator: " <> ",
+			rightCol: "users.limit",
+			expectedJoins: []join{
+				{queryType: QueryBasic, joinType: "INNER JOIN", table: "orders", leftCol: "orders.total", operator: "<>", rightCol: "users.limit"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -193,6 +211,7 @@ func TestBuilder_RightJoin(t *testing.T) {
 
 	tests := []struct {
 		name          string
+		dialect       Dialect
 		initialJoins  []join
 		table         string
 		leftCol       string
@@ -257,13 +276,22 @@ func TestBuilder_RightJoin(t *testing.T) {
 			rightCol:    "",
 			expectedErr: ErrInvalidJoinCondition,
 		},
+		{
+			name:        "should return an error if dialect does not support RIGHT JOIN",
+			dialect:     limitedDialect{},
+			table:       "orders",
+			leftCol:     "users.id",
+			operator:    "=",
+			rightCol:    "orders.user_id",
+			expectedErr: ErrUnsupportedFeature,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			b := &builder{joins: tt.initialJoins}
+			b := &builder{dialect: tt.dialect, joins: tt.initialJoins}
 			result := b.RightJoin(tt.table, tt.leftCol, tt.operator, tt.rightCol)
 
 			if tt.expectedErr != nil {
@@ -279,6 +307,635 @@ func TestBuilder_RightJoin(t *testing.T) {
 	}
 }
 
+func TestBuilder_FullJoin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       Dialect
+		table         string
+		leftCol       string
+		operator      string
+		rightCol      string
+		expectedJoins []join
+		expectedErr   error
+	}{
+		{
+			name:     "should add a simple FULL JOIN clause",
+			table:    "orders",
+			leftCol:  "users.id",
+			operator: "=",
+			rightCol: "orders.user_id",
+			expectedJoins: []join{
+				{queryType: QueryBasic, joinType: "FULL JOIN", table: "orders", leftCol: "users.id", operator: "=", rightCol: "orders.user_id"},
+			},
+		},
+		{
+			name:        "should return an error if right column is empty",
+			table:       "orders",
+			leftCol:     "users.id",
+			operator:    "=",
+			rightCol:    "",
+			expectedErr: ErrInvalidJoinCondition,
+		},
+		{
+			name:        "should return an error if dialect does not support FULL JOIN",
+			dialect:     limitedDialect{},
+			table:       "orders",
+			leftCol:     "users.id",
+			operator:    "=",
+			rightCol:    "orders.user_id",
+			expectedErr: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{dialect: tt.dialect}
+			result := b.FullJoin(tt.table, tt.leftCol, tt.operator, tt.rightCol)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedJoins, b.joins, "expected joins to be updated correctly")
+			assert.Equal(t, b, result, "expected FullJoin() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_CrossJoin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       Dialect
+		build         func(*builder) QueryBuilder
+		expectedJoins []join
+		expectedErr   error
+	}{
+		{
+			name: "should add a CROSS JOIN clause",
+			build: func(b *builder) QueryBuilder {
+				return b.CrossJoin("sizes s")
+			},
+			expectedJoins: []join{
+				{queryType: QueryBasic, joinType: "CROSS JOIN", table: "sizes s"},
+			},
+		},
+		{
+			name: "should add a NATURAL JOIN clause",
+			build: func(b *builder) QueryBuilder {
+				return b.NaturalJoin("profiles")
+			},
+			expectedJoins: []join{
+				{queryType: QueryBasic, joinType: "NATURAL JOIN", table: "profiles"},
+			},
+		},
+		{
+			name: "should return an error if table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.CrossJoin("")
+			},
+			expectedErr: ErrEmptyTable,
+		},
+		{
+			name:    "should return an error if dialect does not support NATURAL JOIN",
+			dialect: limitedDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.NaturalJoin("profiles")
+			},
+			expectedErr: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{dialect: tt.dialect}
+			result := tt.build(b)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedJoins, b.joins, "expected joins to be updated correctly")
+			assert.Equal(t, b, result, "expected CrossJoin() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_JoinUsing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       Dialect
+		table         string
+		columns       []string
+		expectedJoins []join
+		expectedErr   error
+	}{
+		{
+			name:    "should add a JOIN USING clause",
+			table:   "orders",
+			columns: []string{"user_id", "tenant_id"},
+			expectedJoins: []join{
+				{queryType: QueryBasic, joinType: "INNER JOIN", table: "orders", using: []string{"user_id", "tenant_id"}},
+			},
+		},
+		{
+			name:        "should return an error if no columns are given",
+			table:       "orders",
+			expectedErr: ErrInvalidJoinCondition,
+		},
+		{
+			name:        "should return an error if a column is empty",
+			table:       "orders",
+			columns:     []string{"user_id", ""},
+			expectedErr: ErrEmptyColumn,
+		},
+		{
+			name:        "should return an error if table is empty",
+			table:       "",
+			columns:     []string{"user_id"},
+			expectedErr: ErrEmptyTable,
+		},
+		{
+			name:        "should return an error if dialect does not support JOIN USING",
+			dialect:     limitedDialect{},
+			table:       "orders",
+			columns:     []string{"user_id"},
+			expectedErr: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{dialect: tt.dialect}
+			result := b.JoinUsing(tt.table, tt.columns...)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedJoins, b.joins, "expected joins to be updated correctly")
+			assert.Equal(t, b, result, "expected JoinUsing() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_JoinLateral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		dialect     Dialect
+		build       func(*builder) QueryBuilder
+		expectedLen int
+		expectedErr error
+	}{
+		{
+			name:    "should add a LEFT JOIN LATERAL clause",
+			dialect: PostgresDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.LeftJoinLateral(func(qb QueryBuilder) {
+					qb.Select("id").From("orders").Limit(3)
+				}, "recent")
+			},
+			expectedLen: 1,
+		},
+		{
+			name:    "should return an error if subquery is nil",
+			dialect: PostgresDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.JoinLateral(nil, "recent")
+			},
+			expectedErr: ErrNilFunc,
+		},
+		{
+			name:    "should return an error if alias is empty",
+			dialect: PostgresDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.JoinLateral(func(qb QueryBuilder) {
+					qb.Select("id").From("orders")
+				}, "")
+			},
+			expectedErr: ErrEmptyAlias,
+		},
+		{
+			name:    "should propagate an error from the subquery",
+			dialect: PostgresDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.LeftJoinLateral(func(qb QueryBuilder) {
+					qb.Select("id").From("")
+				}, "recent")
+			},
+			expectedErr: ErrEmptyTable,
+		},
+		{
+			name:    "should return an error if dialect does not support LATERAL",
+			dialect: limitedDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.LeftJoinLateral(func(qb QueryBuilder) {
+					qb.Select("id").From("orders")
+				}, "recent")
+			},
+			expectedErr: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{dialect: tt.dialect}
+			result := tt.build(b)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Len(t, b.joins, tt.expectedLen, "expected joins to be updated correctly")
+			if tt.expectedLen > 0 {
+				assert.Equal(t, QuerySub, b.joins[0].queryType, "expected a subquery join")
+				assert.Equal(t, "recent", b.joins[0].table, "expected alias to match")
+			}
+			assert.Equal(t, b, result, "expected JoinLateral() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_JoinSub(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedJoins []join
+		expectedErr   error
+	}{
+		{
+			name: "should add an INNER JOIN against a subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinSub(func(qb QueryBuilder) {
+					qb.Select("user_id").From("orders")
+				}, "o", "o.user_id", "=", "users.id")
+			},
+			expectedJoins: []join{
+				{
+					queryType: QuerySub,
+					joinType:  "INNER JOIN",
+					table:     "o",
+					leftCol:   "o.user_id",
+					operator:  "=",
+					rightCol:  "users.id",
+					sub:       New(nil).Select("user_id").From("orders"),
+				},
+			},
+		},
+		{
+			name: "should add a LEFT JOIN against a subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.LeftJoinSub(func(qb QueryBuilder) {
+					qb.Select("user_id").From("orders")
+				}, "o", "o.user_id", " = ", "users.id")
+			},
+			expectedJoins: []join{
+				{
+					queryType: QuerySub,
+					joinType:  "LEFT JOIN",
+					table:     "o",
+					leftCol:   "o.user_id",
+					operator:  "=",
+					rightCol:  "users.id",
+					sub:       New(nil).Select("user_id").From("orders"),
+				},
+			},
+		},
+		{
+			name: "should return an error if subquery is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinSub(nil, "o", "o.user_id", "=", "users.id")
+			},
+			expectedErr: ErrNilFunc,
+		},
+		{
+			name: "should return an error if alias is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinSub(func(qb QueryBuilder) {
+					qb.Select("user_id").From("orders")
+				}, "", "o.user_id", "=", "users.id")
+			},
+			expectedErr: ErrEmptyAlias,
+		},
+		{
+			name: "should return an error if the condition is incomplete",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinSub(func(qb QueryBuilder) {
+					qb.Select("user_id").From("orders")
+				}, "o", "o.user_id", "=", "")
+			},
+			expectedErr: ErrInvalidJoinCondition,
+		},
+		{
+			name: "should return an error if operator is not allowed",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinSub(func(qb QueryBuilder) {
+					qb.Select("user_id").From("orders")
+				}, "o", "o.user_id", "= users.id OR 1 =", "users.id")
+			},
+			expectedErr: ErrInvalidOperator,
+		},
+		{
+			name: "should propagate an error from the subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.LeftJoinSub(func(qb QueryBuilder) {
+					qb.Select("user_id").From("")
+				}, "o", "o.user_id", "=", "users.id")
+			},
+			expectedErr: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{}
+			result := tt.build(b)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedJoins, b.joins, "expected joins to be updated correctly")
+			assert.Equal(t, b, result, "expected JoinSub() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_JoinRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          string
+		args          []any
+		expectedJoins []join
+		expectedErr   error
+	}{
+		{
+			name: "should add a raw join",
+			expr: "LEFT JOIN orders o ON o.user_id = users.id AND o.total > ?",
+			args: []any{100},
+			expectedJoins: []join{
+				{queryType: QueryRaw, expr: "LEFT JOIN orders o ON o.user_id = users.id AND o.total > ?", args: []any{100}},
+			},
+		},
+		{
+			name:        "should return an error if expression is empty",
+			expr:        "",
+			expectedErr: ErrEmptyExpression,
+		},
+		{
+			name:        "should return an error if args do not match placeholders",
+			expr:        "JOIN orders o ON o.total > ?",
+			args:        []any{},
+			expectedErr: ErrArgCountMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{}
+			result := b.JoinRaw(tt.expr, tt.args...)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedJoins, b.joins, "expected joins to be updated correctly")
+			assert.Equal(t, b, result, "expected JoinRaw() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_JoinOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       Dialect
+		build         func(*builder) QueryBuilder
+		expectedJoins []join
+		expectedErr   error
+	}{
+		{
+			name: "should add an INNER JOIN with a closure condition",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinOn("orders", func(jc JoinClause) {
+					jc.On("orders.user_id", "=", "users.id").WhereNull("orders.deleted_at")
+				})
+			},
+			expectedJoins: []join{
+				{queryType: QueryNested, joinType: "INNER JOIN", table: "orders", on: []where{
+					{queryType: QueryColumn, conj: "AND", column: "orders.user_id", operator: "=", expr: "users.id"},
+					{queryType: QueryNull, conj: "AND", column: "orders.deleted_at", operator: "IS NULL", args: []any{}},
+				}},
+			},
+		},
+		{
+			name: "should add LEFT and RIGHT JOINs with closure conditions",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					LeftJoinOn("orders o", func(jc JoinClause) {
+						jc.On("o.user_id", "=", "users.id")
+					}).
+					RightJoinOn("invoices", func(jc JoinClause) {
+						jc.On("invoices.order_id", "=", "o.id")
+					})
+			},
+			expectedJoins: []join{
+				{queryType: QueryNested, joinType: "LEFT JOIN", table: "orders o", on: []where{
+					{queryType: QueryColumn, conj: "AND", column: "o.user_id", operator: "=", expr: "users.id"},
+				}},
+				{queryType: QueryNested, joinType: "RIGHT JOIN", table: "invoices", on: []where{
+					{queryType: QueryColumn, conj: "AND", column: "invoices.order_id", operator: "=", expr: "o.id"},
+				}},
+			},
+		},
+		{
+			name: "should return an error if table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinOn("", func(jc JoinClause) {
+					jc.On("orders.user_id", "=", "users.id")
+				})
+			},
+			expectedErr: ErrEmptyTable,
+		},
+		{
+			name: "should return an error if closure is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinOn("orders", nil)
+			},
+			expectedErr: ErrNilFunc,
+		},
+		{
+			name: "should return an error if closure adds no condition",
+			build: func(b *builder) QueryBuilder {
+				return b.LeftJoinOn("orders", func(jc JoinClause) {})
+			},
+			expectedErr: ErrInvalidJoinCondition,
+		},
+		{
+			name: "should propagate an error from the closure",
+			build: func(b *builder) QueryBuilder {
+				return b.JoinOn("orders", func(jc JoinClause) {
+					jc.On("orders.user_id", "=", "users.id").Where("orders.status", "= 1 OR", "x")
+				})
+			},
+			expectedErr: ErrInvalidOperator,
+		},
+		{
+			name:    "should return an error if dialect does not support RIGHT JOIN",
+			dialect: limitedDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.RightJoinOn("orders", func(jc JoinClause) {
+					jc.On("orders.user_id", "=", "users.id")
+				})
+			},
+			expectedErr: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{dialect: tt.dialect}
+			result := tt.build(b)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedJoins, b.joins, "expected joins to be updated correctly")
+			assert.Equal(t, b, result, "expected JoinOn() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_ArrayJoin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		dialect            Dialect
+		build              func(*builder) QueryBuilder
+		expectedArrayJoins []arrayJoin
+		expectedErr        error
+	}{
+		{
+			name: "should add an ARRAY JOIN clause",
+			build: func(b *builder) QueryBuilder {
+				return b.ArrayJoin("tags")
+			},
+			expectedArrayJoins: []arrayJoin{
+				{joinType: "ARRAY JOIN", columns: []string{"tags"}},
+			},
+		},
+		{
+			name: "should add a LEFT ARRAY JOIN clause with aliased columns",
+			build: func(b *builder) QueryBuilder {
+				return b.LeftArrayJoin("tags as tag", "scores as score")
+			},
+			expectedArrayJoins: []arrayJoin{
+				{joinType: "LEFT ARRAY JOIN", columns: []string{"tags as tag", "scores as score"}},
+			},
+		},
+		{
+			name: "should keep multiple ARRAY JOIN clauses in order",
+			build: func(b *builder) QueryBuilder {
+				return b.ArrayJoin("tags").LeftArrayJoin("scores")
+			},
+			expectedArrayJoins: []arrayJoin{
+				{joinType: "ARRAY JOIN", columns: []string{"tags"}},
+				{joinType: "LEFT ARRAY JOIN", columns: []string{"scores"}},
+			},
+		},
+		{
+			name: "should return an error if no columns are given",
+			build: func(b *builder) QueryBuilder {
+				return b.ArrayJoin()
+			},
+			expectedErr: ErrEmptyColumn,
+		},
+		{
+			name: "should return an error if a column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.LeftArrayJoin("tags", "")
+			},
+			expectedErr: ErrEmptyColumn,
+		},
+		{
+			name:    "should return an error if dialect does not support ARRAY JOIN",
+			dialect: limitedDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.ArrayJoin("tags")
+			},
+			expectedErr: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &builder{dialect: tt.dialect}
+			result := tt.build(b)
+
+			if tt.expectedErr != nil {
+				assert.Error(t, b.err, "expected an error")
+				assert.ErrorIs(t, b.err, tt.expectedErr, "expected error message to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedArrayJoins, b.arrayJoins, "expected array joins to be updated correctly")
+			assert.Equal(t, b, result, "expected ArrayJoin() to return the same builder instance")
+		})
+	}
+}
+
 // -----------------
 // --- BENCHMARK ---
 // -----------------
@@ -318,3 +975,47 @@ func BenchmarkBuilder_RightJoin(b *testing.B) {
 		builder.RightJoin(table, leftCol, operator, rightCol)
 	}
 }
+
+func BenchmarkBuilder_FullJoin(b *testing.B) {
+	builder := &builder{}
+	table := "orders"
+	leftCol := "users.id"
+	operator := "="
+	rightCol := "orders.user_id"
+
+	for b.Loop() {
+		builder.FullJoin(table, leftCol, operator, rightCol)
+	}
+}
+
+func BenchmarkBuilder_JoinUsing(b *testing.B) {
+	for b.Loop() {
+		builder := &builder{}
+		builder.JoinUsing("orders", "user_id", "tenant_id")
+	}
+}
+
+func BenchmarkBuilder_JoinRaw(b *testing.B) {
+	for b.Loop() {
+		builder := &builder{}
+		builder.JoinRaw("LEFT JOIN orders o ON o.user_id = users.id AND o.total > ?", 100)
+	}
+}
+
+func BenchmarkBuilder_JoinOn(b *testing.B) {
+	fn := func(jc JoinClause) {
+		jc.On("orders.user_id", "=", "users.id").WhereNull("orders.deleted_at")
+	}
+
+	for b.Loop() {
+		builder := &builder{}
+		builder.JoinOn("orders", fn)
+	}
+}
+
+func BenchmarkBuilder_ArrayJoin(b *testing.B) {
+	for b.Loop() {
+		builder := &builder{}
+		builder.ArrayJoin("tags as tag")
+	}
+}
@@ -19,3 +19,38 @@ func (b *builder) Offset(offset int) QueryBuilder {
 
 	return b
 }
+
+func (b *builder) LimitBy(limit int, columns ...string) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsLimitBy {
+		b.addErr(unsupportedFeature("LIMIT BY"))
+		return b
+	}
+
+	if len(columns) == 0 {
+		b.addErr(ErrEmptyColumn)
+		return b
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	if limit < 0 {
+		limit = 0
+	}
+
+	b.limitBy = &limitBy{
+		limit:   limit,
+		columns: append([]string(nil), columns...),
+	}
+
+	return b
+}
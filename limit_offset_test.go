@@ -90,6 +90,78 @@ func TestBuilder_Offset(t *testing.T) {
 	}
 }
 
+func TestBuilder_LimitBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		dialect         Dialect
+		limit           int
+		columns         []string
+		expectedLimitBy *limitBy
+		expectedError   error
+	}{
+		{
+			name:            "should set LIMIT BY correctly",
+			limit:           5,
+			columns:         []string{"user_id"},
+			expectedLimitBy: &limitBy{limit: 5, columns: []string{"user_id"}},
+		},
+		{
+			name:            "should set LIMIT BY with multiple columns",
+			limit:           1,
+			columns:         []string{"user_id", "page"},
+			expectedLimitBy: &limitBy{limit: 1, columns: []string{"user_id", "page"}},
+		},
+		{
+			name:            "should set limit to 0 if negative",
+			limit:           -3,
+			columns:         []string{"user_id"},
+			expectedLimitBy: &limitBy{limit: 0, columns: []string{"user_id"}},
+		},
+		{
+			name:          "should return error when no columns are given",
+			limit:         5,
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when a column is empty",
+			limit:         5,
+			columns:       []string{"user_id", ""},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when dialect does not support LIMIT BY",
+			dialect:       limitedDialect{},
+			limit:         5,
+			columns:       []string{"user_id"},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.LimitBy(tt.limit, tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedLimitBy, b.limitBy, "expected limit by to be set correctly")
+			assert.Equal(t, b, result, "expected LimitBy() to return the same builder instance")
+		})
+	}
+}
+
 // -----------------
 // --- BENCHMARK ---
 // -----------------
@@ -111,3 +183,12 @@ func BenchmarkBuilder_Offset(b *testing.B) {
 		builder.Offset(offset)
 	}
 }
+
+func BenchmarkBuilder_LimitBy(b *testing.B) {
+	builder := &builder{}
+	columns := []string{"user_id", "page"}
+
+	for b.Loop() {
+		builder.LimitBy(5, columns...)
+	}
+}
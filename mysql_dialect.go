@@ -0,0 +1,215 @@
+package sequel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// mysqlMaxLimit is the documented way to express "no limit" in MySQL, which
+// does not accept an OFFSET without a LIMIT.
+const mysqlMaxLimit = "18446744073709551615"
+
+var mysqlOperators = comparisonOperators.with(
+	"<=>", "REGEXP", "NOT REGEXP", "RLIKE", "NOT RLIKE", "SOUNDS LIKE",
+)
+
+// MySQLDialect targets MySQL. IdentifierPattern, when set, must match every
+// part of an identifier.
+type MySQLDialect struct {
+	IdentifierPattern *regexp.Regexp
+}
+
+func (d MySQLDialect) Capabilities() DialectCapabilities {
+	return DialectCapabilities{
+		SupportsArrayJoin:       false,
+		SupportsDistinctOn:      false,
+		SupportsExcept:          false,
+		SupportsFinal:           false,
+		SupportsFullJoin:        false,
+		SupportsIntersect:       false,
+		SupportsJoinUsing:       true,
+		SupportsLateral:         true,
+		SupportsLimitBy:         false,
+		SupportsMaterializedCTE: false,
+		SupportsNaturalJoin:     true,
+		SupportsPreWhere:        false,
+		SupportsReturning:       false,
+		SupportsRightJoin:       true,
+		SupportsSample:          false,
+		SupportsUpsert:          true,
+	}
+}
+
+func (d MySQLDialect) Placeholder(n int) string {
+	return "?"
+}
+
+func (d MySQLDialect) SupportsOperator(operator string) bool {
+	return mysqlOperators.has(operator)
+}
+
+func (d MySQLDialect) ValidIdentifier(id string) bool {
+	return validIdentifier(id, d.IdentifierPattern)
+}
+
+func (d MySQLDialect) WrapColumn(expr string) string {
+	return backticks.column(expr)
+}
+
+func (d MySQLDialect) WrapIdentifier(id string) string {
+	return backticks.identifier(id)
+}
+
+func (d MySQLDialect) WrapTable(expr string) string {
+	return backticks.table(expr)
+}
+
+func (d MySQLDialect) compiler() compiler {
+	return compiler{
+		dialect:          d,
+		backslashEscapes: true,
+		paging:           d.compilePaging,
+		conflict:         d.compileConflictClause,
+		updateTarget:     d.compileUpdateTarget,
+		updateFrom:       d.compileJoinedTables,
+		deleteTarget:     d.compileDeleteTarget,
+		deleteFrom:       d.compileJoinedTables,
+	}
+}
+
+func (d MySQLDialect) CompileSelect(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileSelect, b)
+}
+
+func (d MySQLDialect) CompileInsert(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileInsert, b)
+}
+
+func (d MySQLDialect) CompileUpdate(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileUpdate, b)
+}
+
+func (d MySQLDialect) CompileDelete(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileDelete, b)
+}
+
+// compilePaging writes LIMIT / OFFSET. MySQL requires a LIMIT before any
+// OFFSET.
+func (d MySQLDialect) compilePaging(c compiler, b *builder) string {
+	var sb strings.Builder
+
+	if b.limit >= 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", b.limit))
+	}
+	if b.offset >= 0 {
+		if b.limit < 0 {
+			sb.WriteString(" LIMIT ")
+			sb.WriteString(mysqlMaxLimit)
+		}
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", b.offset))
+	}
+
+	return sb.String()
+}
+
+// compileUpdateTarget lists every table of a multi-table update before SET.
+func (d MySQLDialect) compileUpdateTarget(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString("UPDATE ")
+	sb.WriteString(d.WrapTable(b.table.name))
+	if len(b.using) > 0 {
+		tableList, err := c.compileTableList(b.using, globalArgs)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(", ")
+		sb.WriteString(tableList)
+	}
+	sb.WriteString(" SET ")
+
+	return sb.String(), nil
+}
+
+// compileDeleteTarget compiles a joined delete as DELETE target FROM table,
+// others. The target must be the alias when the table has one.
+func (d MySQLDialect) compileDeleteTarget(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	if len(b.using) == 0 {
+		return "DELETE FROM " + d.WrapTable(b.table.name), nil
+	}
+
+	parts := strings.Fields(b.table.name)
+	tableList, err := c.compileTableList(b.using, globalArgs)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("DELETE ")
+	sb.WriteString(d.WrapIdentifier(parts[len(parts)-1]))
+	sb.WriteString(" FROM ")
+	sb.WriteString(d.WrapTable(b.table.name))
+	sb.WriteString(", ")
+	sb.WriteString(tableList)
+
+	return sb.String(), nil
+}
+
+// compileJoinedTables writes nothing after the assignments of an UPDATE or
+// the target of a DELETE, the target hooks already listed the joined tables.
+func (d MySQLDialect) compileJoinedTables(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	return "", nil
+}
+
+// compileConflictClause compiles the upsert as ON DUPLICATE KEY UPDATE.
+// MySQL picks the conflicting key itself, so the conflict target is ignored.
+func (d MySQLDialect) compileConflictClause(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	var sb strings.Builder
+
+	switch b.conflict.action {
+	case "NOTHING":
+		// MySQL has no DO NOTHING; assigning a column to itself is the
+		// usual no-op that still skips the duplicate row.
+		if len(b.insertColumns) == 0 {
+			return "", ErrInvalidConflict
+		}
+
+		col := d.WrapIdentifier(b.insertColumns[0])
+		sb.WriteString(" ON DUPLICATE KEY UPDATE ")
+		sb.WriteString(col)
+		sb.WriteString(" = ")
+		sb.WriteString(col)
+
+	case "UPDATE":
+		if len(b.conflict.sets) == 0 {
+			return "", ErrInvalidConflict
+		}
+
+		if len(b.conflict.wheres) > 0 {
+			return "", unsupportedFeature("ON CONFLICT WHERE")
+		}
+
+		// EXCLUDED.col is spelled VALUES(col) in MySQL
+		sets := make([]set, len(b.conflict.sets))
+		for i, s := range b.conflict.sets {
+			if s.queryType == QueryExcluded {
+				col := d.WrapIdentifier(s.column)
+				s = set{
+					queryType: QueryRaw,
+					expr:      col + " = VALUES(" + col + ")",
+				}
+			}
+			sets[i] = s
+		}
+
+		sb.WriteString(" ON DUPLICATE KEY UPDATE ")
+		sb.WriteString(c.compileSetClause(sets, globalArgs))
+
+	default:
+		return "", ErrInvalidConflict
+	}
+
+	return sb.String(), nil
+}
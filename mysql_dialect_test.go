@@ -0,0 +1,1526 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLDialect_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		expectedArrayJoin       bool
+		expectedDistinctOn      bool
+		expectedExcept          bool
+		expectedFinal           bool
+		expectedFullJoin        bool
+		expectedIntersect       bool
+		expectedJoinUsing       bool
+		expectedLateral         bool
+		expectedLimitBy         bool
+		expectedMaterializedCTE bool
+		expectedNaturalJoin     bool
+		expectedPreWhere        bool
+		expectedReturning       bool
+		expectedRightJoin       bool
+		expectedSample          bool
+		expectedUpsert          bool
+	}{
+		{
+			name:                    "should return correct capabilities for MySQL",
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          false,
+			expectedFinal:           false,
+			expectedFullJoin:        false,
+			expectedIntersect:       false,
+			expectedJoinUsing:       true,
+			expectedLateral:         true,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: false,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       false,
+			expectedRightJoin:       true,
+			expectedSample:          false,
+			expectedUpsert:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := MySQLDialect{}
+
+			// Act
+			caps := d.Capabilities()
+
+			// Assert
+			assert.Equal(t, tt.expectedArrayJoin, caps.SupportsArrayJoin, "expected SupportsArrayJoin to match")
+			assert.Equal(t, tt.expectedDistinctOn, caps.SupportsDistinctOn, "expected SupportsDistinctOn to match")
+			assert.Equal(t, tt.expectedExcept, caps.SupportsExcept, "expected SupportsExcept to match")
+			assert.Equal(t, tt.expectedFinal, caps.SupportsFinal, "expected SupportsFinal to match")
+			assert.Equal(t, tt.expectedFullJoin, caps.SupportsFullJoin, "expected SupportsFullJoin to match")
+			assert.Equal(t, tt.expectedIntersect, caps.SupportsIntersect, "expected SupportsIntersect to match")
+			assert.Equal(t, tt.expectedJoinUsing, caps.SupportsJoinUsing, "expected SupportsJoinUsing to match")
+			assert.Equal(t, tt.expectedLateral, caps.SupportsLateral, "expected SupportsLateral to match")
+			assert.Equal(t, tt.expectedLimitBy, caps.SupportsLimitBy, "expected SupportsLimitBy to match")
+			assert.Equal(t, tt.expectedMaterializedCTE, caps.SupportsMaterializedCTE, "expected SupportsMaterializedCTE to match")
+			assert.Equal(t, tt.expectedNaturalJoin, caps.SupportsNaturalJoin, "expected SupportsNaturalJoin to match")
+			assert.Equal(t, tt.expectedPreWhere, caps.SupportsPreWhere, "expected SupportsPreWhere to match")
+			assert.Equal(t, tt.expectedReturning, caps.SupportsReturning, "expected SupportsReturning to match")
+			assert.Equal(t, tt.expectedRightJoin, caps.SupportsRightJoin, "expected SupportsRightJoin to match")
+			assert.Equal(t, tt.expectedSample, caps.SupportsSample, "expected SupportsSample to match")
+			assert.Equal(t, tt.expectedUpsert, caps.SupportsUpsert, "expected SupportsUpsert to match")
+		})
+	}
+}
+
+func TestMySQLDialect_Placeholder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{
+			name:     "should return ? for first placeholder",
+			n:        1,
+			expected: "?",
+		},
+		{
+			name:     "should return ? for tenth placeholder",
+			n:        10,
+			expected: "?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := MySQLDialect{}
+
+			// Act
+			result := d.Placeholder(tt.n)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected placeholder to match")
+		})
+	}
+}
+
+func TestMySQLDialect_SupportsOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept =",
+			operator: "=",
+			expected: true,
+		},
+		{
+			name:     "should accept <=>",
+			operator: "<=>",
+			expected: true,
+		},
+		{
+			name:     "should accept regexp",
+			operator: "regexp",
+			expected: true,
+		},
+		{
+			name:     "should reject ILIKE",
+			operator: "ILIKE",
+			expected: false,
+		},
+		{
+			name:     "should reject @>",
+			operator: "@>",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := MySQLDialect{}
+
+			// Act
+			result := d.SupportsOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
+func TestMySQLDialect_WrapColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote simple column without alias",
+			input:    "id",
+			expected: "`id`",
+		},
+		{
+			name:     "should quote column with alias using AS",
+			input:    "name AS username",
+			expected: "`name` AS `username`",
+		},
+		{
+			name:     "should quote column with lowercase as",
+			input:    "name as username",
+			expected: "`name` AS `username`",
+		},
+		{
+			name:     "should quote table.column with alias",
+			input:    "users.id AS user_id",
+			expected: "`users`.`id` AS `user_id`",
+		},
+		{
+			name:     "should handle extra spaces before alias",
+			input:    "email     AS    email_address",
+			expected: "`email` AS `email_address`",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := MySQLDialect{}
+
+			// Act
+			result := d.WrapColumn(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted column to match")
+		})
+	}
+}
+
+func TestMySQLDialect_WrapIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote single identifier",
+			input:    "users",
+			expected: "`users`",
+		},
+		{
+			name:     "should quote database and table",
+			input:    "app.users",
+			expected: "`app`.`users`",
+		},
+		{
+			name:     "should quote database, table and column",
+			input:    "app.users.id",
+			expected: "`app`.`users`.`id`",
+		},
+		{
+			name:     "should double embedded quotes",
+			input:    "user`name",
+			expected: "`user``name`",
+		},
+		{
+			name:     "should keep a bare star",
+			input:    "*",
+			expected: "*",
+		},
+		{
+			name:     "should keep a qualified star unquoted",
+			input:    "users.*",
+			expected: "`users`.*",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := MySQLDialect{}
+
+			// Act
+			result := d.WrapIdentifier(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted identifier to match")
+		})
+	}
+}
+
+func TestMySQLDialect_WrapTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote table without alias",
+			input:    "users",
+			expected: "`users`",
+		},
+		{
+			name:     "should quote table with alias",
+			input:    "users u",
+			expected: "`users` AS `u`",
+		},
+		{
+			name:     "should quote database qualified table with alias",
+			input:    "app.users u",
+			expected: "`app`.`users` AS `u`",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := MySQLDialect{}
+
+			// Act
+			result := d.WrapTable(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted table to match")
+		})
+	}
+}
+
+func TestMySQLDialect_Select(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build select all query when columns are empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users")
+			},
+			expectedSQL:  "SELECT * FROM `users`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build select with table alias and qualified columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("u.id", "u.email AS user_email").
+					From("app.users u")
+			},
+			expectedSQL:  "SELECT `u`.`id`, `u`.`email` AS `user_email` FROM `app`.`users` AS `u`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build select with raw expression and args",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					AddSelectRaw("price * ? AS discounted", 0.9).
+					From("products")
+			},
+			expectedSQL:  "SELECT `id`, price * ? AS discounted FROM `products`",
+			expectedArgs: []any{0.9},
+		},
+		{
+			name: "should build select with subquery column",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					AddSelectSub(func(q QueryBuilder) {
+						q.SelectRaw("COUNT(*)").From("orders").Where("status", "=", "paid")
+					}, "paid_orders").
+					From("users").
+					Where("active", "=", true)
+			},
+			expectedSQL:  "SELECT `id`, (SELECT COUNT(*) FROM `orders` WHERE `status` = ?) AS `paid_orders` FROM `users` WHERE `active` = ?",
+			expectedArgs: []any{"paid", true},
+		},
+		{
+			name: "should build select from subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("t.id").
+					FromSub(func(q QueryBuilder) {
+						q.Select("id").From("users").Where("age", ">", 18)
+					}, "t").
+					Where("t.id", "<", 100)
+			},
+			expectedSQL:  "SELECT `t`.`id` FROM (SELECT `id` FROM `users` WHERE `age` > ?) AS `t` WHERE `t`.`id` < ?",
+			expectedArgs: []any{18, 100},
+		},
+		{
+			name: "should build select with joins",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("u.id", "o.total").
+					From("users u").
+					Join("orders o", "o.user_id", "=", "u.id").
+					LeftJoin("profiles p", "p.user_id", "=", "u.id")
+			},
+			expectedSQL:  "SELECT `u`.`id`, `o`.`total` FROM `users` AS `u` INNER JOIN `orders` AS `o` ON `o`.`user_id` = `u`.`id` LEFT JOIN `profiles` AS `p` ON `p`.`user_id` = `u`.`id`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build select with join conditions",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("u.id").
+					From("users u").
+					JoinOn("orders o", func(jc JoinClause) {
+						jc.On("o.user_id", "=", "u.id").Where("o.status", "=", "paid")
+					}).
+					RightJoin("teams t", "t.id", "=", "u.team_id")
+			},
+			expectedSQL:  "SELECT `u`.`id` FROM `users` AS `u` INNER JOIN `orders` AS `o` ON `o`.`user_id` = `u`.`id` AND `o`.`status` = ? RIGHT JOIN `teams` AS `t` ON `t`.`id` = `u`.`team_id`",
+			expectedArgs: []any{"paid"},
+		},
+		{
+			name: "should build select with using, natural and cross joins",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					JoinUsing("profiles", "user_id").
+					NaturalJoin("settings").
+					CrossJoin("regions")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` INNER JOIN `profiles` USING (`user_id`) NATURAL JOIN `settings` CROSS JOIN `regions`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build select with subquery and lateral joins",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("u.id", "o.total", "l.last_login").
+					From("users u").
+					JoinSub(func(q QueryBuilder) {
+						q.Select("user_id").AddSelectRaw("SUM(total) AS total").From("orders").Where("status", "=", "paid").GroupBy("user_id")
+					}, "o", "o.user_id", "=", "u.id").
+					JoinLateral(func(q QueryBuilder) {
+						q.SelectRaw("MAX(created_at) AS last_login").From("logins").WhereRaw("logins.user_id = u.id")
+					}, "l").
+					Where("u.active", "=", true)
+			},
+			expectedSQL:  "SELECT `u`.`id`, `o`.`total`, `l`.`last_login` FROM `users` AS `u` INNER JOIN (SELECT `user_id`, SUM(total) AS total FROM `orders` WHERE `status` = ? GROUP BY `user_id`) AS `o` ON `o`.`user_id` = `u`.`id` INNER JOIN LATERAL (SELECT MAX(created_at) AS last_login FROM `logins` WHERE logins.user_id = u.id) AS `l` ON TRUE WHERE `u`.`active` = ?",
+			expectedArgs: []any{"paid", true},
+		},
+		{
+			name: "should build select with raw join",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("u.id").
+					From("users u").
+					JoinRaw("LEFT JOIN orders o ON o.user_id = u.id AND o.total > ?", 100).
+					Where("u.active", "=", true)
+			},
+			expectedSQL:  "SELECT `u`.`id` FROM `users` AS `u` LEFT JOIN orders o ON o.user_id = u.id AND o.total > ? WHERE `u`.`active` = ?",
+			expectedArgs: []any{100, true},
+		},
+		{
+			name: "should build select with only a subquery column",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectSub(func(q QueryBuilder) {
+						q.SelectRaw("COUNT(*)").From("orders").Where("status", "=", "paid")
+					}, "paid_orders").
+					From("users")
+			},
+			expectedSQL:  "SELECT (SELECT COUNT(*) FROM `orders` WHERE `status` = ?) AS `paid_orders` FROM `users`",
+			expectedArgs: []any{"paid"},
+		},
+		{
+			name: "should build select from raw expression",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectRaw("COUNT(*) AS total").
+					FromRaw("JSON_TABLE(?, '$[*]' COLUMNS (id INT PATH '$')) AS ids", "[1,2]").
+					Where("id", ">", 1)
+			},
+			expectedSQL:  "SELECT COUNT(*) AS total FROM JSON_TABLE(?, '$[*]' COLUMNS (id INT PATH '$')) AS ids WHERE `id` > ?",
+			expectedArgs: []any{"[1,2]", 1},
+		},
+		{
+			name: "should build select with raw group by and order by",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectRaw("YEAR(created_at) AS year, COUNT(*) AS total").
+					From("orders").
+					GroupByRaw("YEAR(created_at)").
+					OrderByRaw("FIELD(status, ?, ?)", "paid", "pending")
+			},
+			expectedSQL:  "SELECT YEAR(created_at) AS year, COUNT(*) AS total FROM `orders` GROUP BY YEAR(created_at) ORDER BY FIELD(status, ?, ?)",
+			expectedArgs: []any{"paid", "pending"},
+		},
+		{
+			name: "should return error when table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("")
+			},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Where(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		build        func(*builder) QueryBuilder
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name: "should build basic where with or",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Where("age", ">", 18).
+					OrWhere("role", "=", "admin")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE `age` > ? OR `role` = ?",
+			expectedArgs: []any{18, "admin"},
+		},
+		{
+			name: "should bind a repeated named arg once per marker",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					WhereRaw("owner = :owner OR editor = :owner", Named("owner", "ann"))
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE owner = ? OR editor = ?",
+			expectedArgs: []any{"ann", "ann"},
+		},
+		{
+			name: "should skip a backslash escaped quote in a raw string",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("notes").
+					WhereRaw(`note = 'it\'s ?' AND id = ?`, 1)
+			},
+			expectedSQL:  "SELECT `id` FROM `notes` WHERE note = 'it\\'s ?' AND id = ?",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should bind named args after a backslash escaped quote",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("notes").
+					WhereRaw(`note <> 'it\'s :skip' AND owner = :owner`, Named("owner", "ann"))
+			},
+			expectedSQL:  "SELECT `id` FROM `notes` WHERE note <> 'it\\'s :skip' AND owner = ?",
+			expectedArgs: []any{"ann"},
+		},
+		{
+			name: "should build between and not between",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					WhereBetween("age", 18, 30).
+					WhereNotBetween("score", 0, 10)
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE (`age` BETWEEN ? AND ?) AND (`score` NOT BETWEEN ? AND ?)",
+			expectedArgs: []any{18, 30, 0, 10},
+		},
+		{
+			name: "should build where in and not in",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					WhereIn("id", 1, 2, 3).
+					WhereNotIn("status", "banned")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE `id` IN (?, ?, ?) AND `status` NOT IN (?)",
+			expectedArgs: []any{1, 2, 3, "banned"},
+		},
+		{
+			name: "should build where null and not null",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					WhereNull("deleted_at").
+					OrWhereNotNull("restored_at")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE `deleted_at` IS NULL OR `restored_at` IS NOT NULL",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build raw where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					WhereRaw("JSON_EXTRACT(meta, '$.plan') = ?", "pro")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE JSON_EXTRACT(meta, '$.plan') = ?",
+			expectedArgs: []any{"pro"},
+		},
+		{
+			name: "should build grouped where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Where("active", "=", true).
+					WhereGroup(func(q QueryBuilder) {
+						q.Where("role", "=", "admin").OrWhere("role", "=", "owner")
+					})
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE `active` = ? AND (`role` = ? OR `role` = ?)",
+			expectedArgs: []any{true, "admin", "owner"},
+		},
+		{
+			name: "should build where subquery and exists in argument order",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Where("active", "=", true).
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").From("orders").Where("total", ">", 100)
+					}).
+					WhereExists(func(q QueryBuilder) {
+						q.Select("id").From("profiles").Where("verified", "=", true)
+					})
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE `active` = ? AND `id` IN (SELECT `user_id` FROM `orders` WHERE `total` > ?) AND EXISTS (SELECT `id` FROM `profiles` WHERE `verified` = ?)",
+			expectedArgs: []any{true, 100, true},
+		},
+		{
+			name: "should build scalar subquery comparison and not exists",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("products").
+					WhereSub("price", ">", func(q QueryBuilder) {
+						q.SelectRaw("AVG(price)").From("products").Where("category", "=", "books")
+					}).
+					WhereNotExists(func(q QueryBuilder) {
+						q.SelectRaw("1").From("recalls").WhereRaw("recalls.product_id = products.id")
+					})
+			},
+			expectedSQL:  "SELECT `id` FROM `products` WHERE `price` > (SELECT AVG(price) FROM `products` WHERE `category` = ?) AND NOT EXISTS (SELECT 1 FROM `recalls` WHERE recalls.product_id = products.id)",
+			expectedArgs: []any{"books"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_OrderBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		build        func(*builder) QueryBuilder
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name: "should build order by columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					OrderBy("name", "asc").
+					OrderBy("id", "desc")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` ORDER BY `name` ASC, `id` DESC",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build raw order by with args",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					OrderByRaw("FIELD(status, ?, ?)", "active", "pending")
+			},
+			expectedSQL:  "SELECT `id` FROM `users` ORDER BY FIELD(status, ?, ?)",
+			expectedArgs: []any{"active", "pending"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		table        string
+		limit        int
+		offset       int
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name:         "should build select with limit",
+			table:        "users",
+			limit:        10,
+			offset:       -1, // default
+			expectedSQL:  "SELECT * FROM `users` LIMIT 10",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should add maximum limit when only offset is set",
+			table:        "users",
+			limit:        -1, // default
+			offset:       5,
+			expectedSQL:  "SELECT * FROM `users` LIMIT 18446744073709551615 OFFSET 5",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with limit and offset",
+			table:        "users",
+			limit:        10,
+			offset:       5,
+			expectedSQL:  "SELECT * FROM `users` LIMIT 10 OFFSET 5",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should ignore negative limit and offset",
+			table:        "users",
+			limit:        -10,
+			offset:       -5,
+			expectedSQL:  "SELECT * FROM `users`",
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should handle zero limit and offset",
+			table:        "users",
+			limit:        0,
+			offset:       0,
+			expectedSQL:  "SELECT * FROM `users` LIMIT 0 OFFSET 0",
+			expectedArgs: []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				action:  "select",
+				table: table{
+					queryType: QueryBasic,
+					name:      tt.table,
+				},
+				limit:  tt.limit,
+				offset: tt.offset,
+			}
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Insert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build insert with multiple rows",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name", "age").
+					Values("John", 30).
+					Values("Jane", 25)
+			},
+			expectedSQL:  "INSERT INTO `users` (`name`, `age`) VALUES (?, ?), (?, ?)",
+			expectedArgs: []any{"John", 30, "Jane", 25},
+		},
+		{
+			name: "should build insert from maps with sorted columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					ValuesMap(map[string]any{"name": "John", "age": 30})
+			},
+			expectedSQL:  "INSERT INTO `users` (`age`, `name`) VALUES (?, ?)",
+			expectedArgs: []any{30, "John"},
+		},
+		{
+			name: "should return error when no values are given",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name")
+			},
+			expectedError: ErrEmptyValues,
+		},
+		{
+			name: "should return error when returning is used",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name").
+					Values("John").
+					Returning("id")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileInsert(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Upsert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build DO NOTHING as a no-op assignment",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email", "name").
+					Values("john@example.com", "John").
+					OnConflict("email").
+					DoNothing()
+			},
+			expectedSQL:  "INSERT INTO `users` (`email`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `email` = `email`",
+			expectedArgs: []any{"john@example.com", "John"},
+		},
+		{
+			name: "should build excluded columns with VALUES()",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email", "name", "age").
+					Values("john@example.com", "John", 30).
+					OnConflict("email").
+					DoUpdateSetExcluded("name", "age")
+			},
+			expectedSQL:  "INSERT INTO `users` (`email`, `name`, `age`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `age` = VALUES(`age`)",
+			expectedArgs: []any{"john@example.com", "John", 30},
+		},
+		{
+			name: "should build raw and column assignments",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email", "name").
+					Values("john@example.com", "John").
+					OnConflict("email").
+					DoUpdateSet("updated_at", Raw("NOW()")).
+					DoUpdateSet("nickname", Col("name"))
+			},
+			expectedSQL:  "INSERT INTO `users` (`email`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `updated_at` = NOW(), `nickname` = `name`",
+			expectedArgs: []any{"john@example.com", "John"},
+		},
+		{
+			name: "should build bound and raw assignments after the values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("inventory").
+					Columns("sku", "stock").
+					Values("A-1", 5).
+					OnConflict("sku").
+					DoUpdateSetRaw("`stock` = `stock` + ?", 5).
+					DoUpdateSet("source", "import")
+			},
+			expectedSQL:  "INSERT INTO `inventory` (`sku`, `stock`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `stock` = `stock` + ?, `source` = ?",
+			expectedArgs: []any{"A-1", 5, 5, "import"},
+		},
+		{
+			name: "should return error when DO NOTHING has no insert columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Values("john@example.com").
+					OnConflict().
+					DoNothing()
+			},
+			expectedError: ErrInvalidConflict,
+		},
+		{
+			name: "should return error when conflict action has a where clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email", "name").
+					Values("john@example.com", "John").
+					OnConflict("email").
+					DoUpdateSetExcluded("name").
+					DoUpdateWhere(func(q QueryBuilder) {
+						q.Where("users.locked", "=", false)
+					})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileInsert(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build update with sets and where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("name", "John").
+					SetRaw("`visits` = `visits` + ?", 1).
+					Where("id", "=", 1)
+			},
+			expectedSQL:  "UPDATE `users` SET `name` = ?, `visits` = `visits` + ? WHERE `id` = ?",
+			expectedArgs: []any{"John", 1, 1},
+		},
+		{
+			name: "should build multi-table update",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("orders o").
+					UpdateFrom("users u").
+					SetRaw("`o`.`status` = ?", "vip").
+					WhereRaw("`o`.`user_id` = `u`.`id`").
+					Where("u.tier", "=", "gold")
+			},
+			expectedSQL:  "UPDATE `orders` AS `o`, `users` AS `u` SET `o`.`status` = ? WHERE `o`.`user_id` = `u`.`id` AND `u`.`tier` = ?",
+			expectedArgs: []any{"vip", "gold"},
+		},
+		{
+			name: "should build full table update when allowed",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false).
+					AllowFullTable()
+			},
+			expectedSQL:  "UPDATE `users` SET `active` = ?",
+			expectedArgs: []any{false},
+		},
+		{
+			name: "should return error when where is missing",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false)
+			},
+			expectedError: ErrUnfilteredUpdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileUpdate(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build delete with where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("users").
+					Where("id", "=", 1)
+			},
+			expectedSQL:  "DELETE FROM `users` WHERE `id` = ?",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build joined delete targeting the table",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("sessions").
+					Using("users").
+					WhereRaw("`sessions`.`user_id` = `users`.`id`").
+					Where("users.banned", "=", true)
+			},
+			expectedSQL:  "DELETE `sessions` FROM `sessions`, `users` WHERE `sessions`.`user_id` = `users`.`id` AND `users`.`banned` = ?",
+			expectedArgs: []any{true},
+		},
+		{
+			name: "should build joined delete targeting the alias",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("sessions s").
+					Using("users u").
+					WhereRaw("`s`.`user_id` = `u`.`id`").
+					Where("u.banned", "=", true)
+			},
+			expectedSQL:  "DELETE `s` FROM `sessions` AS `s`, `users` AS `u` WHERE `s`.`user_id` = `u`.`id` AND `u`.`banned` = ?",
+			expectedArgs: []any{true},
+		},
+		{
+			name: "should return error when where is missing",
+			build: func(b *builder) QueryBuilder {
+				return b.DeleteFrom("users")
+			},
+			expectedError: ErrUnfilteredDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileDelete(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Union(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build UNION ALL with trailing order and limit",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Where("status", "=", "active").
+					UnionAll(func(q QueryBuilder) {
+						q.Select("id").From("admins").Where("level", ">", 2)
+					}).
+					OrderBy("id", "asc").
+					Limit(10)
+			},
+			expectedSQL:  "SELECT `id` FROM `users` WHERE `status` = ? UNION ALL (SELECT `id` FROM `admins` WHERE `level` > ?) ORDER BY `id` ASC LIMIT 10",
+			expectedArgs: []any{"active", 2},
+		},
+		{
+			name: "should return error for INTERSECT",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Intersect(func(q QueryBuilder) {
+						q.Select("id").From("admins")
+					})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return error for EXCEPT",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Except(func(q QueryBuilder) {
+						q.Select("id").From("admins")
+					})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_GroupByHaving(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		build        func(*builder) QueryBuilder
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name: "should build group by and having in argument order",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id").
+					AddSelectRaw("SUM(total) AS spent").
+					From("orders").
+					Where("status", "=", "paid").
+					GroupBy("user_id").
+					HavingRaw("SUM(total) > ?", 100).
+					OrderBy("user_id", "asc")
+			},
+			expectedSQL:  "SELECT `user_id`, SUM(total) AS spent FROM `orders` WHERE `status` = ? GROUP BY `user_id` HAVING SUM(total) > ? ORDER BY `user_id` ASC",
+			expectedArgs: []any{"paid", 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_With(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build recursive CTE",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					WithRecursive("tree", []string{"id", "parent_id"}, func(q QueryBuilder) {
+						q.Select("id", "parent_id").From("categories").Where("id", "=", 1)
+					}).
+					Select("id").
+					From("tree")
+			},
+			expectedSQL:  "WITH RECURSIVE `tree` (`id`, `parent_id`) AS (SELECT `id`, `parent_id` FROM `categories` WHERE `id` = ?) SELECT `id` FROM `tree`",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should return error for MATERIALIZED hint",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					WithMaterialized("recent", func(q QueryBuilder) {
+						q.Select("id").From("orders")
+					}).
+					Select("id").
+					From("recent")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(MySQLDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestMySQLDialect_Distinct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build select distinct",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("country").
+					Distinct().
+					From("users")
+			},
+			expectedSQL:  "SELECT DISTINCT `country` FROM `users`",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error for DISTINCT ON",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					DistinctOn("user_id").
+					From("orders")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: MySQLDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkMySQLDialect_Select(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: MySQLDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Select("id", "name").
+			From("users").
+			Where("active", "=", true).
+			WhereIn("role", "admin", "owner").
+			OrderBy("id", "desc").
+			Limit(10).
+			Offset(20)
+
+		_, _, _ = bd.dialect.CompileSelect(bd)
+	}
+}
+
+func BenchmarkMySQLDialect_Insert(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: MySQLDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Insert("users").
+			Columns("name", "email").
+			Values("John", "john@example.com").
+			Values("Jane", "jane@example.com")
+
+		_, _, _ = bd.dialect.CompileInsert(bd)
+	}
+}
@@ -0,0 +1,140 @@
+package sequel
+
+import (
+	"reflect"
+	"strings"
+)
+
+// NamedArg binds Value to the :Name markers of a raw expression.
+type NamedArg struct {
+	Name  string
+	Value any
+}
+
+func Named(name string, value any) NamedArg {
+	return NamedArg{Name: name, Value: value}
+}
+
+// namedArgs returns the values of args by name when they bind :name markers
+// instead of "?" ones: Named values, or a single map or struct argument
+// (fields named by their db tag) when expr has no "?" markers.
+func namedArgs(expr string, args []any, backslash bool) (map[string]any, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+
+	if _, ok := args[0].(NamedArg); ok {
+		named := make(map[string]any, len(args))
+		for _, arg := range args {
+			na, ok := arg.(NamedArg)
+			if !ok {
+				return nil, false
+			}
+			named[na.Name] = na.Value
+		}
+
+		return named, true
+	}
+
+	// A lone map or struct without :name markers (e.g. time.Time) is a value
+	if len(args) > 1 || countRawMarkers(expr, backslash) > 0 || !hasNamedMarkers(expr, backslash) {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(args[0])
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+
+		named := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			named[iter.Key().String()] = iter.Value().Interface()
+		}
+
+		return named, true
+
+	case reflect.Struct:
+		t := v.Type()
+		named := make(map[string]any, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name := field.Name
+			if tag, ok := field.Tag.Lookup("db"); ok {
+				tag, _, _ = strings.Cut(tag, ",")
+				if tag == "-" {
+					continue
+				}
+				if tag != "" {
+					name = tag
+				}
+			}
+
+			named[name] = v.Field(i).Interface()
+		}
+
+		return named, true
+
+	default:
+		return nil, false
+	}
+}
+
+// hasNamedMarkers reports whether expr contains any :name marker.
+func hasNamedMarkers(expr string, backslash bool) bool {
+	found := false
+	rewriteRaw(expr, backslash, func() string { return "?" }, func(name string) string {
+		found = true
+		return ""
+	})
+
+	return found
+}
+
+// checkRawArgs reports whether args fit the markers of a raw expression:
+// one arg per "?" marker, or a value for every :name marker.
+func checkRawArgs(expr string, args []any, backslash bool) error {
+	named, ok := namedArgs(expr, args, backslash)
+	if !ok {
+		if countRawMarkers(expr, backslash) != len(args) {
+			return ErrArgCountMismatch
+		}
+
+		return nil
+	}
+
+	var missing bool
+	_, n := rewriteRaw(expr, backslash, func() string { return "?" }, func(name string) string {
+		if _, ok := named[name]; !ok {
+			missing = true
+		}
+		return ""
+	})
+
+	if n > 0 {
+		return ErrArgCountMismatch
+	}
+
+	if missing {
+		return ErrMissingNamedArg
+	}
+
+	return nil
+}
+
+// checkRawArgs checks args against expr, reading its quoted strings the way
+// the dialect of the builder does.
+func (b *builder) checkRawArgs(expr string, args []any) error {
+	backslash := b.dialect != nil && b.dialect.compiler().backslashEscapes
+	return checkRawArgs(expr, args, backslash)
+}
@@ -0,0 +1,180 @@
+package sequel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamed(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	result := Named("since", 10)
+
+	// Assert
+	assert.Equal(t, NamedArg{Name: "since", Value: 10}, result, "expected named arg to match")
+}
+
+func TestNamedArgs(t *testing.T) {
+	t.Parallel()
+
+	type filter struct {
+		Owner   string `db:"owner"`
+		Since   int    `db:"since,omitempty"`
+		Limit   int
+		Ignored string `db:"-"`
+		secret  string
+	}
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		expr          string
+		args          []any
+		expectedNamed map[string]any
+		expectedOk    bool
+	}{
+		{
+			name:          "should collect Named values",
+			expr:          "created_at > :since AND owner = :owner",
+			args:          []any{Named("since", since), Named("owner", "ann")},
+			expectedNamed: map[string]any{"since": since, "owner": "ann"},
+			expectedOk:    true,
+		},
+		{
+			name:          "should collect map values",
+			expr:          "owner = :owner",
+			args:          []any{map[string]any{"owner": "ann"}},
+			expectedNamed: map[string]any{"owner": "ann"},
+			expectedOk:    true,
+		},
+		{
+			name:          "should collect exported struct fields by db tag",
+			expr:          "owner = :owner AND since > :since",
+			args:          []any{filter{Owner: "ann", Since: 3, Limit: 5, Ignored: "x", secret: "y"}},
+			expectedNamed: map[string]any{"owner": "ann", "since": 3, "Limit": 5},
+			expectedOk:    true,
+		},
+		{
+			name:          "should collect struct fields through a pointer",
+			expr:          "owner = :owner",
+			args:          []any{&filter{Owner: "ann"}},
+			expectedNamed: map[string]any{"owner": "ann", "since": 0, "Limit": 0},
+			expectedOk:    true,
+		},
+		{
+			name:       "should treat a struct as a value when expr uses ?",
+			expr:       "created_at > ?",
+			args:       []any{since},
+			expectedOk: false,
+		},
+		{
+			name:       "should treat a map as a value when expr has no :name markers",
+			expr:       "data = data",
+			args:       []any{map[string]any{"a": 1}},
+			expectedOk: false,
+		},
+		{
+			name:       "should treat plain args as positional",
+			expr:       "a = ? AND b = ?",
+			args:       []any{1, 2},
+			expectedOk: false,
+		},
+		{
+			name:       "should return false without args",
+			expr:       "a = :a",
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			named, ok := namedArgs(tt.expr, tt.args, false)
+
+			// Assert
+			assert.Equal(t, tt.expectedOk, ok, "expected named mode to match")
+			assert.Equal(t, tt.expectedNamed, named, "expected named values to match")
+		})
+	}
+}
+
+func TestCheckRawArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          string
+		args          []any
+		expectedError error
+	}{
+		{
+			name: "should accept matching positional args",
+			expr: "a = ? AND b = ?",
+			args: []any{1, 2},
+		},
+		{
+			name: "should accept a named value used twice",
+			expr: "a = :v OR b = :v",
+			args: []any{Named("v", 1)},
+		},
+		{
+			name: "should ignore casts and unused names",
+			expr: "a::text = :v",
+			args: []any{map[string]any{"v": "x", "unused": 1}},
+		},
+		{
+			name:          "should reject positional count mismatch",
+			expr:          "a = ?",
+			args:          []any{},
+			expectedError: ErrArgCountMismatch,
+		},
+		{
+			name:          "should reject a name without a value",
+			expr:          "a = :a AND b = :b",
+			args:          []any{Named("a", 1)},
+			expectedError: ErrMissingNamedArg,
+		},
+		{
+			name:          "should reject ? markers mixed with Named values",
+			expr:          "a = :a AND b = ?",
+			args:          []any{Named("a", 1)},
+			expectedError: ErrArgCountMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := checkRawArgs(tt.expr, tt.args, false)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkNamedArgs(b *testing.B) {
+	expr := "created_at > :since AND owner = :owner"
+	args := []any{Named("since", 10), Named("owner", "ann")}
+
+	for b.Loop() {
+		namedArgs(expr, args, false)
+	}
+}
@@ -0,0 +1,71 @@
+package sequel
+
+import "strings"
+
+type operatorSet map[string]struct{}
+
+// comparisonOperators are accepted by every dialect; dialects add their own.
+var comparisonOperators = newOperatorSet(
+	"=", "<>", "!=", "<", "<=", ">", ">=",
+	"LIKE", "NOT LIKE",
+)
+
+func newOperatorSet(operators ...string) operatorSet {
+	set := make(operatorSet, len(operators))
+	for _, op := range operators {
+		set[op] = struct{}{}
+	}
+
+	return set
+}
+
+// with returns a copy of s extended by operators.
+func (s operatorSet) with(operators ...string) operatorSet {
+	set := make(operatorSet, len(s)+len(operators))
+	for op := range s {
+		set[op] = struct{}{}
+	}
+	for _, op := range operators {
+		set[op] = struct{}{}
+	}
+
+	return set
+}
+
+func (s operatorSet) has(operator string) bool {
+	_, ok := s[normalizeOperator(operator)]
+	return ok
+}
+
+// normalizeOperator upper cases operator and collapses its inner whitespace,
+// so "is  distinct from" and "IS DISTINCT FROM" are the same operator.
+func normalizeOperator(operator string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(operator), " "))
+}
+
+// supportsOperator checks operator against the dialect allowlist, or the
+// shared comparison operators when the builder has no dialect yet.
+func (b *builder) supportsOperator(operator string) bool {
+	if b.dialect == nil {
+		return comparisonOperators.has(operator)
+	}
+
+	return b.dialect.SupportsOperator(operator)
+}
+
+// supportsSubOperator also accepts IN / NOT IN and the ANY, ALL and SOME
+// quantifiers of a subquery comparison (e.g. "> ALL").
+func (b *builder) supportsSubOperator(operator string) bool {
+	switch operator {
+	case "IN", "NOT IN":
+		return true
+	}
+
+	for _, quantifier := range []string{" ANY", " ALL", " SOME"} {
+		if base, ok := strings.CutSuffix(operator, quantifier); ok {
+			return b.supportsOperator(base)
+		}
+	}
+
+	return b.supportsOperator(operator)
+}
@@ -0,0 +1,113 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected string
+	}{
+		{
+			name:     "should keep symbolic operators",
+			operator: ">=",
+			expected: ">=",
+		},
+		{
+			name:     "should upper case keyword operators",
+			operator: "ilike",
+			expected: "ILIKE",
+		},
+		{
+			name:     "should collapse and trim whitespace",
+			operator: "  is   distinct\tfrom ",
+			expected: "IS DISTINCT FROM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result := normalizeOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected normalized operator to match")
+		})
+	}
+}
+
+func TestBuilder_SupportsSubOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		dialect  Dialect
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept IN",
+			operator: "IN",
+			expected: true,
+		},
+		{
+			name:     "should accept a plain comparison",
+			operator: ">",
+			expected: true,
+		},
+		{
+			name:     "should accept a quantified comparison",
+			operator: "> ALL",
+			expected: true,
+		},
+		{
+			name:     "should reject a quantified unknown operator",
+			operator: "; DROP ANY",
+			expected: false,
+		},
+		{
+			name:     "should use the dialect allowlist",
+			dialect:  PostgresDialect{},
+			operator: "@> ANY",
+			expected: true,
+		},
+		{
+			name:     "should reject dialect operators without a dialect",
+			operator: "@>",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.supportsSubOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkNormalizeOperator(b *testing.B) {
+	for b.Loop() {
+		normalizeOperator("is not distinct from")
+	}
+}
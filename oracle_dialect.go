@@ -0,0 +1,130 @@
+package sequel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var oracleOperators = comparisonOperators.with(
+	"^=",
+)
+
+// oracleQuotes upper cases all-lowercase names, as Oracle folds unquoted
+// names to upper case, so they keep naming the same object.
+var oracleQuotes = quoter{open: '"', close: '"', upperCase: true, omitTableAs: true}
+
+// oracleCompoundOperators spells EXCEPT the Oracle way.
+var oracleCompoundOperators = map[string]string{"EXCEPT": "MINUS"}
+
+// OracleDialect targets Oracle. IdentifierPattern, when set, must match every
+// part of an identifier.
+type OracleDialect struct {
+	IdentifierPattern *regexp.Regexp
+}
+
+func (d OracleDialect) Capabilities() DialectCapabilities {
+	return DialectCapabilities{
+		SupportsArrayJoin:       false,
+		SupportsDistinctOn:      false,
+		SupportsExcept:          true, // compiled as MINUS
+		SupportsFinal:           false,
+		SupportsFullJoin:        true,
+		SupportsIntersect:       true,
+		SupportsJoinUsing:       true,
+		SupportsLateral:         false, // no boolean literal for ON TRUE before 23ai
+		SupportsLimitBy:         false,
+		SupportsMaterializedCTE: false,
+		SupportsNaturalJoin:     true,
+		SupportsPreWhere:        false,
+		SupportsReturning:       false,
+		SupportsRightJoin:       true,
+		SupportsSample:          false,
+		SupportsUpsert:          false,
+	}
+}
+
+func (d OracleDialect) Placeholder(n int) string {
+	return fmt.Sprintf(":%d", n)
+}
+
+func (d OracleDialect) SupportsOperator(operator string) bool {
+	return oracleOperators.has(operator)
+}
+
+func (d OracleDialect) ValidIdentifier(id string) bool {
+	return validIdentifier(id, d.IdentifierPattern)
+}
+
+func (d OracleDialect) WrapColumn(expr string) string {
+	return oracleQuotes.column(expr)
+}
+
+func (d OracleDialect) WrapIdentifier(id string) string {
+	return oracleQuotes.identifier(id)
+}
+
+func (d OracleDialect) WrapTable(expr string) string {
+	return oracleQuotes.table(expr)
+}
+
+func (d OracleDialect) compiler() compiler {
+	return compiler{
+		dialect:           d,
+		omitRecursive:     true,
+		omitTableAs:       true,
+		selectOnlyCTEs:    true,
+		compoundOperators: oracleCompoundOperators,
+		paging:            d.compilePaging,
+		multiRowInsert:    d.compileInsertAll,
+		updateFrom:        compiler.rejectUpdateFrom,
+		deleteFrom:        compiler.rejectDeleteUsing,
+	}
+}
+
+func (d OracleDialect) CompileSelect(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileSelect, b)
+}
+
+func (d OracleDialect) CompileInsert(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileInsert, b)
+}
+
+func (d OracleDialect) CompileUpdate(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileUpdate, b)
+}
+
+func (d OracleDialect) CompileDelete(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileDelete, b)
+}
+
+// compilePaging writes OFFSET / FETCH FIRST.
+func (d OracleDialect) compilePaging(c compiler, b *builder) string {
+	var sb strings.Builder
+
+	if b.offset >= 0 {
+		sb.WriteString(fmt.Sprintf(" OFFSET %d ROWS", b.offset))
+	}
+	if b.limit >= 0 {
+		sb.WriteString(fmt.Sprintf(" FETCH FIRST %d ROWS ONLY", b.limit))
+	}
+
+	return sb.String()
+}
+
+// compileInsertAll compiles a multi-row insert as INSERT ALL, Oracle has no
+// multi-row VALUES lists.
+func (d OracleDialect) compileInsertAll(c compiler, into string, rows [][]any, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	sb.WriteString("INSERT ALL")
+	for _, row := range rows {
+		sb.WriteString(" ")
+		sb.WriteString(into)
+		sb.WriteString(" VALUES ")
+		sb.WriteString(c.compileValuesClause([][]any{row}, globalArgs))
+	}
+	sb.WriteString(" SELECT 1 FROM DUAL")
+
+	return sb.String()
+}
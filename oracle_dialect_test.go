@@ -0,0 +1,634 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOracleDialect_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		expectedArrayJoin       bool
+		expectedDistinctOn      bool
+		expectedExcept          bool
+		expectedFinal           bool
+		expectedFullJoin        bool
+		expectedIntersect       bool
+		expectedJoinUsing       bool
+		expectedLateral         bool
+		expectedLimitBy         bool
+		expectedMaterializedCTE bool
+		expectedNaturalJoin     bool
+		expectedPreWhere        bool
+		expectedReturning       bool
+		expectedRightJoin       bool
+		expectedSample          bool
+		expectedUpsert          bool
+	}{
+		{
+			name:                    "should return correct capabilities for Oracle",
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        true,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         false,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: false,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       false,
+			expectedRightJoin:       true,
+			expectedSample:          false,
+			expectedUpsert:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := OracleDialect{}
+
+			// Act
+			caps := d.Capabilities()
+
+			// Assert
+			assert.Equal(t, tt.expectedArrayJoin, caps.SupportsArrayJoin, "expected SupportsArrayJoin to match")
+			assert.Equal(t, tt.expectedDistinctOn, caps.SupportsDistinctOn, "expected SupportsDistinctOn to match")
+			assert.Equal(t, tt.expectedExcept, caps.SupportsExcept, "expected SupportsExcept to match")
+			assert.Equal(t, tt.expectedFinal, caps.SupportsFinal, "expected SupportsFinal to match")
+			assert.Equal(t, tt.expectedFullJoin, caps.SupportsFullJoin, "expected SupportsFullJoin to match")
+			assert.Equal(t, tt.expectedIntersect, caps.SupportsIntersect, "expected SupportsIntersect to match")
+			assert.Equal(t, tt.expectedJoinUsing, caps.SupportsJoinUsing, "expected SupportsJoinUsing to match")
+			assert.Equal(t, tt.expectedLateral, caps.SupportsLateral, "expected SupportsLateral to match")
+			assert.Equal(t, tt.expectedLimitBy, caps.SupportsLimitBy, "expected SupportsLimitBy to match")
+			assert.Equal(t, tt.expectedMaterializedCTE, caps.SupportsMaterializedCTE, "expected SupportsMaterializedCTE to match")
+			assert.Equal(t, tt.expectedNaturalJoin, caps.SupportsNaturalJoin, "expected SupportsNaturalJoin to match")
+			assert.Equal(t, tt.expectedPreWhere, caps.SupportsPreWhere, "expected SupportsPreWhere to match")
+			assert.Equal(t, tt.expectedReturning, caps.SupportsReturning, "expected SupportsReturning to match")
+			assert.Equal(t, tt.expectedRightJoin, caps.SupportsRightJoin, "expected SupportsRightJoin to match")
+			assert.Equal(t, tt.expectedSample, caps.SupportsSample, "expected SupportsSample to match")
+			assert.Equal(t, tt.expectedUpsert, caps.SupportsUpsert, "expected SupportsUpsert to match")
+		})
+	}
+}
+
+func TestOracleDialect_Placeholder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{
+			name:     "should return :1 for first placeholder",
+			n:        1,
+			expected: ":1",
+		},
+		{
+			name:     "should return :10 for tenth placeholder",
+			n:        10,
+			expected: ":10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := OracleDialect{}
+
+			// Act
+			result := d.Placeholder(tt.n)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected placeholder to match")
+		})
+	}
+}
+
+func TestOracleDialect_WrapIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should upper case lowercase identifier",
+			input:    "users",
+			expected: `"USERS"`,
+		},
+		{
+			name:     "should upper case each lowercase part",
+			input:    "hr.employees.id",
+			expected: `"HR"."EMPLOYEES"."ID"`,
+		},
+		{
+			name:     "should keep upper case identifier",
+			input:    "EMPLOYEES",
+			expected: `"EMPLOYEES"`,
+		},
+		{
+			name:     "should keep mixed case identifier as written",
+			input:    "hr.OrderItems",
+			expected: `"HR"."OrderItems"`,
+		},
+		{
+			name:     "should double embedded quotes",
+			input:    `user"name`,
+			expected: `"USER""NAME"`,
+		},
+		{
+			name:     "should keep a bare star",
+			input:    "*",
+			expected: "*",
+		},
+		{
+			name:     "should keep a qualified star unquoted",
+			input:    "users.*",
+			expected: `"USERS".*`,
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := OracleDialect{}
+
+			// Act
+			result := d.WrapIdentifier(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted identifier to match")
+		})
+	}
+}
+
+func TestOracleDialect_SupportsOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept ^=",
+			operator: "^=",
+			expected: true,
+		},
+		{
+			name:     "should accept LIKE",
+			operator: "LIKE",
+			expected: true,
+		},
+		{
+			name:     "should reject ILIKE",
+			operator: "ILIKE",
+			expected: false,
+		},
+		{
+			name:     "should reject <=>",
+			operator: "<=>",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := OracleDialect{}
+
+			// Act
+			result := d.SupportsOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
+func TestOracleDialect_WrapColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote simple column",
+			input:    "id",
+			expected: `"ID"`,
+		},
+		{
+			name:     "should keep AS for column alias",
+			input:    "e.first_name AS name",
+			expected: `"E"."FIRST_NAME" AS "NAME"`,
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := OracleDialect{}
+
+			// Act
+			result := d.WrapColumn(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted column to match")
+		})
+	}
+}
+
+func TestOracleDialect_WrapTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote table without alias",
+			input:    "employees",
+			expected: `"EMPLOYEES"`,
+		},
+		{
+			name:     "should write alias without AS",
+			input:    "hr.employees e",
+			expected: `"HR"."EMPLOYEES" "E"`,
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := OracleDialect{}
+
+			// Act
+			result := d.WrapTable(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted table to match")
+		})
+	}
+}
+
+func TestOracleDialect_Select(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build select with joins and where",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("e.id", "d.name AS department").
+					From("employees e").
+					LeftJoin("departments d", "d.id", "=", "e.department_id").
+					Where("e.salary", ">", 5000).
+					WhereIn("d.code", "FIN", "ACC")
+			},
+			expectedSQL:  `SELECT "E"."ID", "D"."NAME" AS "DEPARTMENT" FROM "EMPLOYEES" "E" LEFT JOIN "DEPARTMENTS" "D" ON "D"."ID" = "E"."DEPARTMENT_ID" WHERE "E"."SALARY" > :1 AND "D"."CODE" IN (:2, :3)`,
+			expectedArgs: []any{5000, "FIN", "ACC"},
+		},
+		{
+			name: "should bind a repeated named arg once per marker",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("employees").
+					Where("active", "=", 1).
+					WhereRaw("owner_id = :user OR manager_id = :user", Named("user", 7))
+			},
+			expectedSQL:  `SELECT "ID" FROM "EMPLOYEES" WHERE "ACTIVE" = :1 AND owner_id = :2 OR manager_id = :3`,
+			expectedArgs: []any{1, 7, 7},
+		},
+		{
+			name: "should alias derived table without AS and renumber placeholders",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("t.id").
+					FromSub(func(q QueryBuilder) {
+						q.Select("id").From("employees").Where("salary", ">", 5000)
+					}, "t").
+					Where("t.id", "<", 100)
+			},
+			expectedSQL:  `SELECT "T"."ID" FROM (SELECT "ID" FROM "EMPLOYEES" WHERE "SALARY" > :1) "T" WHERE "T"."ID" < :2`,
+			expectedArgs: []any{5000, 100},
+		},
+		{
+			name: "should join a derived table without AS",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("e.id", "s.total").
+					From("employees e").
+					JoinSub(func(q QueryBuilder) {
+						q.Select("employee_id", "total").From("sales").Where("year", "=", 2024)
+					}, "s", "s.employee_id", "=", "e.id").
+					Where("e.active", "=", 1)
+			},
+			expectedSQL:  `SELECT "E"."ID", "S"."TOTAL" FROM "EMPLOYEES" "E" INNER JOIN (SELECT "EMPLOYEE_ID", "TOTAL" FROM "SALES" WHERE "YEAR" = :1) "S" ON "S"."EMPLOYEE_ID" = "E"."ID" WHERE "E"."ACTIVE" = :2`,
+			expectedArgs: []any{2024, 1},
+		},
+		{
+			name: "should build recursive CTE without RECURSIVE keyword",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					WithRecursive("tree", []string{"id", "manager_id"}, func(q QueryBuilder) {
+						q.Select("id", "manager_id").From("employees").WhereNull("manager_id")
+					}).
+					Select("id").
+					From("tree")
+			},
+			expectedSQL:  `WITH "TREE" ("ID", "MANAGER_ID") AS (SELECT "ID", "MANAGER_ID" FROM "EMPLOYEES" WHERE "MANAGER_ID" IS NULL) SELECT "ID" FROM "TREE"`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should compile EXCEPT as MINUS",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("employees").
+					Except(func(q QueryBuilder) {
+						q.Select("employee_id").From("terminations").Where("year", "=", 2024)
+					})
+			},
+			expectedSQL:  `SELECT "ID" FROM "EMPLOYEES" MINUS (SELECT "EMPLOYEE_ID" FROM "TERMINATIONS" WHERE "YEAR" = :1)`,
+			expectedArgs: []any{2024},
+		},
+		{
+			name: "should return error for DISTINCT ON",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					DistinctOn("department_id").
+					From("employees")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(OracleDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestOracleDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		table        string
+		limit        int
+		offset       int
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name:         "should build select with fetch first",
+			table:        "employees",
+			limit:        10,
+			offset:       -1, // default
+			expectedSQL:  `SELECT * FROM "EMPLOYEES" FETCH FIRST 10 ROWS ONLY`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with offset rows",
+			table:        "employees",
+			limit:        -1, // default
+			offset:       5,
+			expectedSQL:  `SELECT * FROM "EMPLOYEES" OFFSET 5 ROWS`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with offset and fetch first",
+			table:        "employees",
+			limit:        10,
+			offset:       5,
+			expectedSQL:  `SELECT * FROM "EMPLOYEES" OFFSET 5 ROWS FETCH FIRST 10 ROWS ONLY`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should ignore negative limit and offset",
+			table:        "employees",
+			limit:        -10,
+			offset:       -5,
+			expectedSQL:  `SELECT * FROM "EMPLOYEES"`,
+			expectedArgs: []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: OracleDialect{},
+				action:  "select",
+				table: table{
+					queryType: QueryBasic,
+					name:      tt.table,
+				},
+				limit:  tt.limit,
+				offset: tt.offset,
+			}
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestOracleDialect_Write(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build single row insert",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("employees").
+					Columns("name", "salary").
+					Values("John", 5000)
+			},
+			expectedSQL:  `INSERT INTO "EMPLOYEES" ("NAME", "SALARY") VALUES (:1, :2)`,
+			expectedArgs: []any{"John", 5000},
+		},
+		{
+			name: "should build multi-row insert with INSERT ALL",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("employees").
+					Columns("name", "salary").
+					Values("John", 5000).
+					Values("Jane", 6000)
+			},
+			expectedSQL:  `INSERT ALL INTO "EMPLOYEES" ("NAME", "SALARY") VALUES (:1, :2) INTO "EMPLOYEES" ("NAME", "SALARY") VALUES (:3, :4) SELECT 1 FROM DUAL`,
+			expectedArgs: []any{"John", 5000, "Jane", 6000},
+		},
+		{
+			name: "should build update with alias",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("employees e").
+					Set("salary", 5500).
+					Where("e.id", "=", 7)
+			},
+			expectedSQL:  `UPDATE "EMPLOYEES" "E" SET "SALARY" = :1 WHERE "E"."ID" = :2`,
+			expectedArgs: []any{5500, 7},
+		},
+		{
+			name: "should build delete",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					DeleteFrom("employees").
+					Where("id", "=", 7)
+			},
+			expectedSQL:  `DELETE FROM "EMPLOYEES" WHERE "ID" = :1`,
+			expectedArgs: []any{7},
+		},
+		{
+			name: "should return error for joined update",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("employees").
+					UpdateFrom("departments").
+					Set("salary", 5500).
+					Where("departments.code", "=", "FIN")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return error for RETURNING",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					DeleteFrom("employees").
+					Where("id", "=", 7).
+					Returning("id")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(OracleDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkOracleDialect_Select(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: OracleDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Select("id", "name").
+			From("employees").
+			Where("active", "=", 1).
+			WhereIn("department_id", 10, 20).
+			OrderBy("id", "desc").
+			Limit(10).
+			Offset(20)
+
+		_, _, _ = bd.dialect.CompileSelect(bd)
+	}
+}
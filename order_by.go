@@ -10,6 +10,11 @@ func (b *builder) OrderBy(column, dir string) QueryBuilder {
 		return b
 	}
 
+	if !b.validColumn(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
 	dir = strings.ToUpper(dir)
 	if dir != "ASC" && dir != "DESC" {
 		dir = "ASC"
@@ -30,6 +35,11 @@ func (b *builder) OrderByRaw(expr string, args ...any) QueryBuilder {
 		return b
 	}
 
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
 	b.orderBys = append(b.orderBys, orderBy{
 		queryType: QueryRaw,
 		expr:      expr,
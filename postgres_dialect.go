@@ -3,20 +3,39 @@ package sequel
 import (
 	"fmt"
 	"regexp"
-	"strconv"
-	"strings"
 )
 
+var postgresOperators = comparisonOperators.with(
+	"ILIKE", "NOT ILIKE", "SIMILAR TO", "NOT SIMILAR TO",
+	"IS DISTINCT FROM", "IS NOT DISTINCT FROM",
+	"~", "~*", "!~", "!~*",
+	"@>", "<@", "&&", "@@", "?", "?|", "?&",
+)
+
+// PostgresDialect targets Postgres. IdentifierPattern, when set, must match
+// every part of an identifier.
 type PostgresDialect struct {
-	//
+	IdentifierPattern *regexp.Regexp
 }
 
 func (d PostgresDialect) Capabilities() DialectCapabilities {
 	return DialectCapabilities{
-		SupportsExcept:    true,
-		SupportsFullJoin:  true,
-		SupportsIntersect: true,
-		SupportsReturning: true,
+		SupportsArrayJoin:       false,
+		SupportsDistinctOn:      true,
+		SupportsExcept:          true,
+		SupportsFinal:           false,
+		SupportsFullJoin:        true,
+		SupportsIntersect:       true,
+		SupportsJoinUsing:       true,
+		SupportsLateral:         true,
+		SupportsLimitBy:         false,
+		SupportsMaterializedCTE: true,
+		SupportsNaturalJoin:     true,
+		SupportsPreWhere:        false,
+		SupportsReturning:       true,
+		SupportsRightJoin:       true,
+		SupportsSample:          false,
+		SupportsUpsert:          true,
 	}
 }
 
@@ -24,384 +43,76 @@ func (d PostgresDialect) Placeholder(n int) string {
 	return fmt.Sprintf("$%d", n)
 }
 
-func (d PostgresDialect) WrapColumn(expr string) string {
-	if expr == "" {
-		return ""
-	}
-
-	var sb strings.Builder
-	parts := strings.Fields(expr) // preserve original case but split cleanly
-	if len(parts) == 3 && strings.EqualFold(parts[1], "as") {
-		sb.WriteString(d.WrapIdentifier(parts[0])) // column
-		sb.WriteString(" AS ")
-		sb.WriteString(`"`)
-		sb.WriteString(parts[2]) // alias
-		sb.WriteString(`"`)
+func (d PostgresDialect) SupportsOperator(operator string) bool {
+	return postgresOperators.has(operator)
+}
 
-		return sb.String()
-	}
+func (d PostgresDialect) ValidIdentifier(id string) bool {
+	return validIdentifier(id, d.IdentifierPattern)
+}
 
-	return d.WrapIdentifier(expr)
+func (d PostgresDialect) WrapColumn(expr string) string {
+	return doubleQuotes.column(expr)
 }
 
 func (d PostgresDialect) WrapIdentifier(id string) string {
-	if id == "" {
-		return ""
-	}
-
-	var sb strings.Builder
-	parts := strings.Split(id, ".")
-	for i, p := range parts {
-		if i > 0 {
-			sb.WriteString(".")
-		}
-		sb.WriteString(`"`)
-		sb.WriteString(p)
-		sb.WriteString(`"`)
-	}
-
-	return sb.String()
+	return doubleQuotes.identifier(id)
 }
 
 func (d PostgresDialect) WrapTable(expr string) string {
-	if expr == "" {
-		return ""
-	}
-
-	parts := strings.Fields(expr)
-	if len(parts) == 2 {
-		var sb strings.Builder
-		sb.WriteString(d.WrapIdentifier(parts[0]))
-		sb.WriteString(" AS ")
-		sb.WriteString(`"`)
-		sb.WriteString(parts[1]) // alias
-		sb.WriteString(`"`)
+	return doubleQuotes.table(expr)
+}
 
-		return sb.String()
+func (d PostgresDialect) compiler() compiler {
+	return compiler{
+		dialect:           d,
+		reusePlaceholders: true,
+		distinct:          d.compileDistinctClause,
 	}
-
-	return d.WrapIdentifier(expr)
 }
 
 func (d PostgresDialect) CompileSelect(b *builder) (string, []any, error) {
-	if b.err != nil {
-		return "", nil, b.err
-	}
-
-	args := []any{}
-	var sb strings.Builder
-
-	// SELECT clause
-	sb.WriteString("SELECT ")
-	selectClause, err := d.compileSelectClause(b.columns, &args)
-	if err != nil {
-		return "", nil, err
-	}
-	sb.WriteString(selectClause)
-
-	// FROM clause
-	sb.WriteString(" FROM ")
-	fromClause, err := d.compileFromClause(b.table, &args)
-	if err != nil {
-		return "", nil, err
-	}
-	sb.WriteString(fromClause)
-
-	// JOIN clause
-	if len(b.joins) > 0 {
-		joinClause, err := d.compileJoinClause(b.joins, &args)
-		if err != nil {
-			return "", nil, err
-		}
-		sb.WriteString(joinClause)
-	}
-
-	// WHERE clause (recursive)
-	if len(b.wheres) > 0 {
-		whereClause, err := d.compileWhereClause(b.wheres, &args)
-		if err != nil {
-			return "", nil, err
-		}
-
-		sb.WriteString(" WHERE ")
-		sb.WriteString(whereClause)
-	}
-
-	// ORDER BY clause
-	if len(b.orderBys) > 0 {
-		sb.WriteString(" ORDER BY ")
-		sb.WriteString(d.compileOrderByClause(b.orderBys, &args))
-	}
-
-	// LIMIT / OFFSET
-	if b.limit >= 0 {
-		sb.WriteString(fmt.Sprintf(" LIMIT %d", b.limit))
-	}
-	if b.offset >= 0 {
-		sb.WriteString(fmt.Sprintf(" OFFSET %d", b.offset))
-	}
-
-	return sb.String(), args, nil
+	return compileStatement(d.compiler().compileSelect, b)
 }
 
-func (d PostgresDialect) compileSelectClause(columns []column, globalArgs *[]any) (string, error) {
-	var sb strings.Builder
-
-	if len(columns) == 0 {
-		sb.WriteString("*")
-	} else {
-		for i, col := range columns {
-			if i > 0 {
-				sb.WriteString(", ")
-			}
-			switch col.queryType {
-			case QueryBasic:
-				sb.WriteString(d.WrapColumn(col.name))
-
-			case QueryRaw:
-				expr := col.expr
-				for _, arg := range col.args {
-					expr = strings.Replace(expr, "?", d.Placeholder(len(*globalArgs)+1), 1)
-					*globalArgs = append(*globalArgs, arg)
-				}
-				sb.WriteString(expr)
-
-			case QuerySub:
-				subSQL, subArgs, err := col.sub.ToSQL()
-				if err != nil {
-					return "", err
-				}
-
-				// Renumber placeholders inside subquery SQL without collisions
-				base := len(*globalArgs) // number of args already present in the outer query
-				re := regexp.MustCompile(`\$(\d+)`)
-				subSQL = re.ReplaceAllStringFunc(subSQL, func(m string) string {
-					nStr := m[1:] // strip leading '$'
-					n, _ := strconv.Atoi(nStr)
-					return d.Placeholder(base + n) // shift by base
-				})
-
-				// Append subquery args in the same order
-				*globalArgs = append(*globalArgs, subArgs...)
-
-				sb.WriteString("(")
-				sb.WriteString(subSQL)
-				sb.WriteString(")")
-				sb.WriteString(" AS ")
-				sb.WriteString(d.WrapIdentifier(col.name))
-			}
-		}
-	}
-
-	return sb.String(), nil
+func (d PostgresDialect) CompileInsert(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileInsert, b)
 }
 
-func (d PostgresDialect) compileFromClause(table table, globalArgs *[]any) (string, error) {
-	var sb strings.Builder
-
-	switch table.queryType {
-	case QueryBasic:
-		return d.WrapTable(table.name), nil
-
-	case QueryRaw:
-		expr := table.expr
-		for _, arg := range table.args {
-			expr = strings.Replace(expr, "?", d.Placeholder(len(*globalArgs)+1), 1)
-			*globalArgs = append(*globalArgs, arg)
-		}
-		sb.WriteString(expr)
-
-	case QuerySub:
-		subSQL, subArgs, err := table.sub.ToSQL()
-		if err != nil {
-			return "", err
-		}
-
-		// Renumber placeholders inside subquery SQL without collisions
-		base := len(*globalArgs) // number of args already present in the outer query
-		re := regexp.MustCompile(`\$(\d+)`)
-		subSQL = re.ReplaceAllStringFunc(subSQL, func(m string) string {
-			nStr := m[1:] // strip leading '$'
-			n, _ := strconv.Atoi(nStr)
-			return d.Placeholder(base + n) // shift by base
-		})
-
-		// Append subquery args in the same order
-		*globalArgs = append(*globalArgs, subArgs...)
-
-		sb.WriteString("(")
-		sb.WriteString(subSQL)
-		sb.WriteString(")")
-
-		if table.name != "" {
-			sb.WriteString(" AS ")
-			sb.WriteString(d.WrapIdentifier(table.name))
-		}
-	}
-
-	return sb.String(), nil
+func (d PostgresDialect) CompileUpdate(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileUpdate, b)
 }
 
-func (d PostgresDialect) compileJoinClause(joins []join, globalArgs *[]any) (string, error) {
-	var sb strings.Builder
-
-	for _, j := range joins {
-		switch j.queryType {
-		case QueryBasic:
-			sb.WriteString(" ")
-			sb.WriteString(j.joinType)
-			sb.WriteString(" ")
-			sb.WriteString(d.WrapTable(j.table))
-			sb.WriteString(" ON ")
-			sb.WriteString(d.WrapColumn(j.leftCol))
-			sb.WriteString(" ")
-			sb.WriteString(j.operator)
-			sb.WriteString(" ")
-			sb.WriteString(d.WrapColumn(j.rightCol))
-		}
-	}
-
-	return sb.String(), nil
+func (d PostgresDialect) CompileDelete(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileDelete, b)
 }
 
-// Recursive WHERE compiler
-func (d PostgresDialect) compileWhereClause(wheres []where, globalArgs *[]any) (string, error) {
-	var sb strings.Builder
+func (d PostgresDialect) compileDistinctClause(c compiler, b *builder) (string, error) {
+	if len(b.distinctOn) == 0 {
+		return "DISTINCT ", nil
+	}
 
-	for i, w := range wheres {
-		if i > 0 {
-			sb.WriteString(" ")
-			sb.WriteString(w.conj)
-			sb.WriteString(" ")
+	// Postgres requires the DISTINCT ON expressions to match the leftmost
+	// ORDER BY expressions (in any order) whenever an ORDER BY is present.
+	if len(b.orderBys) > 0 {
+		if len(b.orderBys) < len(b.distinctOn) {
+			return "", ErrInvalidDistinctOn
 		}
 
-		switch w.queryType {
-		case QueryBasic:
-			sb.WriteString(d.WrapColumn(w.column))
-			sb.WriteString(" ")
-			sb.WriteString(w.operator)
-			sb.WriteString(" ")
-			sb.WriteString(d.Placeholder(len(*globalArgs) + 1))
-			*globalArgs = append(*globalArgs, w.args...)
-
-		case QueryBetween:
-			sb.WriteString("(")
-			sb.WriteString(d.WrapColumn(w.column))
-			sb.WriteString(" ")
-			sb.WriteString(w.operator)
-			sb.WriteString(" ")
-			sb.WriteString(d.Placeholder(len(*globalArgs) + 1))
-			sb.WriteString(" AND ")
-			sb.WriteString(d.Placeholder(len(*globalArgs) + 2))
-			sb.WriteString(")")
-			*globalArgs = append(*globalArgs, w.args...)
-
-		case QueryIn:
-			if len(w.args) == 0 {
-				if w.operator == "NOT IN" {
-					sb.WriteString("1 = 1")
-				} else {
-					sb.WriteString("1 = 0")
-				}
-				return sb.String(), nil
-			}
-
-			sb.WriteString(d.WrapColumn(w.column))
-			sb.WriteString(" ")
-			sb.WriteString(w.operator)
-			sb.WriteString(" (")
-
-			for i := range w.args {
-				if i > 0 {
-					sb.WriteString(", ")
-				}
-				sb.WriteString(d.Placeholder(len(*globalArgs) + i + 1))
-			}
-			sb.WriteString(")")
-			*globalArgs = append(*globalArgs, w.args...)
-
-		case QueryNull:
-			sb.WriteString(d.WrapColumn(w.column))
-			sb.WriteString(" ")
-			sb.WriteString(w.operator)
-
-		case QueryRaw:
-			expr := w.expr
-
-			for _, arg := range w.args {
-				expr = strings.Replace(expr, "?", d.Placeholder(len(*globalArgs)+1), 1)
-				*globalArgs = append(*globalArgs, arg)
-			}
-
-			sb.WriteString(expr)
-
-		case QueryNested:
-			whereClause, err := d.compileWhereClause(w.nested, globalArgs) // recursion updates globalArgs directly
-			if err != nil {
-				return "", err
-			}
-
-			sb.WriteString("(")
-			sb.WriteString(whereClause)
-			sb.WriteString(")")
-
-		case QuerySub:
-			subSQL, subArgs, err := w.sub.ToSQL()
-			if err != nil {
-				return "", err
+		leading := make(map[string]struct{}, len(b.distinctOn))
+		for _, ob := range b.orderBys[:len(b.distinctOn)] {
+			if ob.queryType != QueryBasic {
+				return "", ErrInvalidDistinctOn
 			}
-
-			// Renumber placeholders inside subquery SQL without collisions
-			base := len(*globalArgs) // number of args already present in the outer query
-			re := regexp.MustCompile(`\$(\d+)`)
-			subSQL = re.ReplaceAllStringFunc(subSQL, func(m string) string {
-				nStr := m[1:] // strip leading '$'
-
-				// NOTE: strconv.Atoi cannot fail here because the regex \$(\d+) guarantees nStr contains only digits.
-				// Error handling is unnecessary and was removed to avoid an unreachable branch in coverage.
-				n, _ := strconv.Atoi(nStr)
-
-				return d.Placeholder(base + n) // shift by base
-			})
-
-			// Append subquery args in the same order
-			*globalArgs = append(*globalArgs, subArgs...)
-
-			if w.column != "" {
-				sb.WriteString(d.WrapColumn(w.column))
-				sb.WriteString(" ")
-			}
-			sb.WriteString(w.operator)
-			sb.WriteString(" (")
-			sb.WriteString(subSQL)
-			sb.WriteString(")")
+			leading[ob.column] = struct{}{}
 		}
-	}
-
-	return sb.String(), nil
-}
 
-func (d PostgresDialect) compileOrderByClause(orderBys []orderBy, globalArgs *[]any) string {
-	var sb strings.Builder
-
-	for i, ob := range orderBys {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
-		switch ob.queryType {
-		case QueryBasic:
-			sb.WriteString(d.WrapColumn(ob.column))
-			sb.WriteString(" ")
-			sb.WriteString(ob.dir)
-		case QueryRaw:
-			expr := ob.expr
-			for _, a := range ob.args {
-				expr = strings.Replace(expr, "?", d.Placeholder(len(*globalArgs)+1), 1)
-				*globalArgs = append(*globalArgs, a)
+		for _, col := range b.distinctOn {
+			if _, ok := leading[col]; !ok {
+				return "", ErrInvalidDistinctOn
 			}
-			sb.WriteString(expr)
 		}
 	}
 
-	return sb.String()
+	return "DISTINCT ON (" + c.compileColumnExprList(b.distinctOn) + ") ", nil
 }
@@ -10,18 +10,42 @@ func TestPostgresDialect_Capabilities(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name              string
-		expectedExcept    bool
-		expectedFullJoin  bool
-		expectedIntersect bool
-		expectedReturning bool
+		name                    string
+		expectedArrayJoin       bool
+		expectedDistinctOn      bool
+		expectedExcept          bool
+		expectedFinal           bool
+		expectedFullJoin        bool
+		expectedIntersect       bool
+		expectedJoinUsing       bool
+		expectedLateral         bool
+		expectedLimitBy         bool
+		expectedMaterializedCTE bool
+		expectedNaturalJoin     bool
+		expectedPreWhere        bool
+		expectedReturning       bool
+		expectedRightJoin       bool
+		expectedSample          bool
+		expectedUpsert          bool
 	}{
 		{
-			name:              "should return correct capabilities for Postgres",
-			expectedExcept:    true,
-			expectedFullJoin:  true,
-			expectedIntersect: true,
-			expectedReturning: true,
+			name:                    "should return correct capabilities for Postgres",
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      true,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        true,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         true,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: true,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       true,
+			expectedRightJoin:       true,
+			expectedSample:          false,
+			expectedUpsert:          true,
 		},
 	}
 
@@ -36,10 +60,22 @@ func TestPostgresDialect_Capabilities(t *testing.T) {
 			caps := d.Capabilities()
 
 			// Assert
+			assert.Equal(t, tt.expectedArrayJoin, caps.SupportsArrayJoin, "expected SupportsArrayJoin to match")
+			assert.Equal(t, tt.expectedDistinctOn, caps.SupportsDistinctOn, "expected SupportsDistinctOn to match")
 			assert.Equal(t, tt.expectedExcept, caps.SupportsExcept, "expected SupportsExcept to match")
+			assert.Equal(t, tt.expectedFinal, caps.SupportsFinal, "expected SupportsFinal to match")
 			assert.Equal(t, tt.expectedFullJoin, caps.SupportsFullJoin, "expected SupportsFullJoin to match")
 			assert.Equal(t, tt.expectedIntersect, caps.SupportsIntersect, "expected SupportsIntersect to match")
+			assert.Equal(t, tt.expectedJoinUsing, caps.SupportsJoinUsing, "expected SupportsJoinUsing to match")
+			assert.Equal(t, tt.expectedLateral, caps.SupportsLateral, "expected SupportsLateral to match")
+			assert.Equal(t, tt.expectedLimitBy, caps.SupportsLimitBy, "expected SupportsLimitBy to match")
+			assert.Equal(t, tt.expectedMaterializedCTE, caps.SupportsMaterializedCTE, "expected SupportsMaterializedCTE to match")
+			assert.Equal(t, tt.expectedNaturalJoin, caps.SupportsNaturalJoin, "expected SupportsNaturalJoin to match")
+			assert.Equal(t, tt.expectedPreWhere, caps.SupportsPreWhere, "expected SupportsPreWhere to match")
 			assert.Equal(t, tt.expectedReturning, caps.SupportsReturning, "expected SupportsReturning to match")
+			assert.Equal(t, tt.expectedRightJoin, caps.SupportsRightJoin, "expected SupportsRightJoin to match")
+			assert.Equal(t, tt.expectedSample, caps.SupportsSample, "expected SupportsSample to match")
+			assert.Equal(t, tt.expectedUpsert, caps.SupportsUpsert, "expected SupportsUpsert to match")
 		})
 	}
 }
@@ -90,6 +126,72 @@ func TestPostgresDialect_Placeholder(t *testing.T) {
 	}
 }
 
+func TestPostgresDialect_SupportsOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept =",
+			operator: "=",
+			expected: true,
+		},
+		{
+			name:     "should accept not ilike",
+			operator: "not ilike",
+			expected: true,
+		},
+		{
+			name:     "should accept IS DISTINCT FROM",
+			operator: "IS DISTINCT FROM",
+			expected: true,
+		},
+		{
+			name:     "should accept @>",
+			operator: "@>",
+			expected: true,
+		},
+		{
+			name:     "should accept &&",
+			operator: "&&",
+			expected: true,
+		},
+		{
+			name:     "should reject <=>",
+			operator: "<=>",
+			expected: false,
+		},
+		{
+			name:     "should reject injected SQL",
+			operator: "= 1; DROP TABLE users; --",
+			expected: false,
+		},
+		{
+			name:     "should reject empty operator",
+			operator: "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := PostgresDialect{}
+
+			// Act
+			result := d.SupportsOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
 func TestPostgresDialect_WrapColumn(t *testing.T) {
 	t.Parallel()
 
@@ -219,6 +321,21 @@ func TestPostgresDialect_WrapIdentifier(t *testing.T) {
 			input:    "order-items",
 			expected: `"order-items"`,
 		},
+		{
+			name:     "should double embedded quotes",
+			input:    `user"name`,
+			expected: `"user""name"`,
+		},
+		{
+			name:     "should keep a bare star",
+			input:    "*",
+			expected: "*",
+		},
+		{
+			name:     "should keep a qualified star unquoted",
+			input:    "users.*",
+			expected: `"users".*`,
+		},
 		{
 			name:     "should not quote empty identifier",
 			input:    "",
@@ -1747,7 +1864,7 @@ func TestPostgresDialect_RightJoin(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_Where(t *testing.T) {
+func TestPostgresDialect_JoinOn(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -1757,271 +1874,314 @@ func TestPostgresDialect_Where(t *testing.T) {
 		expectedArgs  []any
 		expectedError error
 	}{
-		// -------------------------------------------
-		// --------------- Where Basic ---------------
-		// -------------------------------------------
 		{
-			name: "should build query with a single basic WHERE clause",
+			name: "should build a composite key join",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("users.id", "orders.id").
 					From("users").
-					Where("id", "=", 1)
+					JoinOn("orders", func(jc JoinClause) {
+						jc.On("orders.user_id", "=", "users.id").On("orders.tenant_id", "=", "users.tenant_id")
+					})
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1`,
-			expectedArgs: []any{1},
+			expectedSQL:  `SELECT "users"."id", "orders"."id" FROM "users" INNER JOIN "orders" ON "orders"."user_id" = "users"."id" AND "orders"."tenant_id" = "users"."tenant_id"`,
+			expectedArgs: []any{},
 		},
 		{
-			name: "should build query with multiple basic where clauses",
+			name: "should number join placeholders before where placeholders",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("users.id").
 					From("users").
-					Where("status", "=", "active").
-					Where("email", "LIKE", "%example.com%")
-			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 AND "email" LIKE $2`,
-			expectedArgs: []any{"active", "%example.com%"},
-		},
-
-		// -------------------------------------------
-		// -------------- Where Between --------------
-		// -------------------------------------------
-		{
-			name: "should build query with BETWEEN operator and multiple values",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("price", "BETWEEN", 10, 50)
+					LeftJoinOn("orders o", func(jc JoinClause) {
+						jc.
+							On("o.user_id", "=", "users.id").
+							WhereNull("o.deleted_at").
+							WhereIn("o.status", "paid", "shipped")
+					}).
+					Where("users.active", "=", true)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
-			expectedArgs: []any{10, 50},
+			expectedSQL:  `SELECT "users"."id" FROM "users" LEFT JOIN "orders" AS "o" ON "o"."user_id" = "users"."id" AND "o"."deleted_at" IS NULL AND "o"."status" IN ($1, $2) WHERE "users"."active" = $3`,
+			expectedArgs: []any{"paid", "shipped", true},
 		},
 		{
-			name: "should build query with BETWEEN operator and a slice",
+			name: "should wrap nested groups in parentheses",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("price", "BETWEEN", []int{10, 50})
+					Select("users.id").
+					From("users").
+					JoinOn("orders", func(jc JoinClause) {
+						jc.
+							On("orders.user_id", "=", "users.id").
+							OnGroup(func(g JoinClause) {
+								g.Where("orders.total", ">", 100).OrOn("orders.owner_id", "=", "users.id")
+							})
+					}).
+					Join("payments", "payments.order_id", "=", "orders.id")
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
-			expectedArgs: []any{10, 50},
+			expectedSQL:  `SELECT "users"."id" FROM "users" INNER JOIN "orders" ON "orders"."user_id" = "users"."id" AND ("orders"."total" > $1 OR "orders"."owner_id" = "users"."id") INNER JOIN "payments" ON "payments"."order_id" = "orders"."id"`,
+			expectedArgs: []any{100},
 		},
 		{
-			name: "should error when BETWEEN operator has a nil 'from' value",
+			name: "should keep conditions after an empty IN",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("price", "BETWEEN", nil, 100)
+					Select("u.id").
+					From("users u").
+					JoinOn("orders o", func(jc JoinClause) {
+						jc.WhereNotIn("o.status", []string{}).On("o.user_id", "=", "u.id")
+					})
 			},
-			expectedError: ErrNilNotAllowed,
+			expectedSQL:  `SELECT "u"."id" FROM "users" AS "u" INNER JOIN "orders" AS "o" ON 1 = 1 AND "o"."user_id" = "u"."id"`,
+			expectedArgs: []any{},
 		},
 		{
-			name: "should error when BETWEEN operator has a nil 'to' value",
+			name: "should return error when the closure adds no condition",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("price", "BETWEEN", 50, nil)
+					Select("users.id").
+					From("users").
+					JoinOn("orders", func(jc JoinClause) {})
 			},
-			expectedError: ErrNilNotAllowed,
+			expectedError: ErrInvalidJoinCondition,
 		},
+	}
 
-		// -------------------------------------------
-		// ------------ Where Not Between ------------
-		// -------------------------------------------
-		{
-			name: "should build query with NOT BETWEEN operator and multiple values",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("price", "NOT BETWEEN", 10, 50)
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
-			expectedArgs: []any{10, 50},
-		},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_FullJoin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
 		{
-			name: "should build query with NOT BETWEEN operator and a slice",
+			name: "should build query with FULL JOIN",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("price", "NOT BETWEEN", []int{10, 50})
+					Select("users.id", "orders.id").
+					From("users").
+					FullJoin("orders", "users.id", "=", "orders.user_id")
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
-			expectedArgs: []any{10, 50},
+			expectedSQL:  `SELECT "users"."id", "orders"."id" FROM "users" FULL JOIN "orders" ON "users"."id" = "orders"."user_id"`,
+			expectedArgs: []any{},
 		},
 		{
-			name: "should error when NOT BETWEEN operator has a nil 'from' value",
+			name: "should build query with CROSS and NATURAL JOINs",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("price", "NOT BETWEEN", nil, 100)
+					Select("p.name", "s.label").
+					From("products p").
+					CrossJoin("sizes s").
+					NaturalJoin("stock")
 			},
-			expectedError: ErrNilNotAllowed,
+			expectedSQL:  `SELECT "p"."name", "s"."label" FROM "products" AS "p" CROSS JOIN "sizes" AS "s" NATURAL JOIN "stock"`,
+			expectedArgs: []any{},
 		},
 		{
-			name: "should error when NOT BETWEEN operator has a nil 'to' value",
+			name: "should build query with JOIN USING",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("price", "NOT BETWEEN", 50, nil)
+					Select("id").
+					From("users").
+					JoinUsing("orders", "user_id", "tenant_id").
+					Where("tenant_id", "=", 7)
 			},
-			expectedError: ErrNilNotAllowed,
+			expectedSQL:  `SELECT "id" FROM "users" INNER JOIN "orders" USING ("user_id", "tenant_id") WHERE "tenant_id" = $1`,
+			expectedArgs: []any{7},
 		},
+	}
 
-		// -------------------------------------------
-		// ---------------- Where In -----------------
-		// -------------------------------------------
-		{
-			name: "should build query with IN operator and a single value",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("category_id", "IN", 1)
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1)`,
-			expectedArgs: []any{1},
-		},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_JoinLateral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
 		{
-			name: "should build query with IN operator and multiple values",
+			name: "should build a top-N-per-group query",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("category_id", "IN", "a", "b", "c")
+					Select("u.id", "recent.total").
+					From("users u").
+					LeftJoinLateral(func(qb QueryBuilder) {
+						qb.
+							Select("total").
+							From("orders").
+							WhereRaw(`orders.user_id = u.id`).
+							Where("status", "=", "paid").
+							OrderBy("created_at", "DESC").
+							Limit(3)
+					}, "recent").
+					Where("u.active", "=", true)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3)`,
-			expectedArgs: []any{"a", "b", "c"},
+			expectedSQL:  `SELECT "u"."id", "recent"."total" FROM "users" AS "u" LEFT JOIN LATERAL (SELECT "total" FROM "orders" WHERE orders.user_id = u.id AND "status" = $1 ORDER BY "created_at" DESC LIMIT 3) AS "recent" ON TRUE WHERE "u"."active" = $2`,
+			expectedArgs: []any{"paid", true},
 		},
 		{
-			name: "should build query with IN operator and a slice of values",
+			name: "should build an inner LATERAL join",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("products").
-					Where("category_id", "IN", []int{1, 2, 3})
+					Select("u.id", "t.tag").
+					From("users u").
+					JoinLateral(func(qb QueryBuilder) {
+						qb.Select("tag").From("user_tags").WhereRaw("user_tags.user_id = u.id")
+					}, "t")
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3)`,
-			expectedArgs: []any{1, 2, 3},
-		},
-		{
-			name: "should build query with IN operator and mixed slices",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("category_id", "IN", []int{1, 2, 3}, []string{"a", "b", "c"})
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3, $4, $5, $6)`,
-			expectedArgs: []any{1, 2, 3, "a", "b", "c"},
+			expectedSQL:  `SELECT "u"."id", "t"."tag" FROM "users" AS "u" INNER JOIN LATERAL (SELECT "tag" FROM "user_tags" WHERE user_tags.user_id = u.id) AS "t" ON TRUE`,
+			expectedArgs: []any{},
 		},
+	}
 
-		// -------------------------------------------
-		// -------------- Where Not In ---------------
-		// -------------------------------------------
-		{
-			name: "should build query with NOT IN operator and a single value",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("category_id", "NOT IN", 1)
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1)`,
-			expectedArgs: []any{1},
-		},
-		{
-			name: "should build query with NOT IN operator and multiple values",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("category_id", "NOT IN", "a", "b", "c")
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1, $2, $3)`,
-			expectedArgs: []any{"a", "b", "c"},
-		},
-		{
-			name: "should build query with NOT IN operator and a slice of values",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("category_id", "NOT IN", []int{1, 2, 3})
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1, $2, $3)`,
-			expectedArgs: []any{1, 2, 3},
-		},
-		{
-			name: "should build query with NOT IN operator and mixed slices",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					Where("category_id", "NOT IN", []int{1, 2, 3}, []string{"a", "b", "c"})
-			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1, $2, $3, $4, $5, $6)`,
-			expectedArgs: []any{1, 2, 3, "a", "b", "c"},
-		},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-		// -------------------------------------------
-		// --------------- Where Null ----------------
-		// -------------------------------------------
-		{
-			name: "should build query with IS NULL operator",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("users").
-					Where("email", "IS NULL")
-			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NULL`,
-			expectedArgs: []any{},
-		},
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_JoinSub(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
 		{
-			name: "should build query with IS NULL operator and ignore extra value",
+			name: "should join a derived table",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("users.id", "o.total").
 					From("users").
-					Where("email", "IS NULL", 123)
+					JoinSub(func(qb QueryBuilder) {
+						qb.Select("user_id").AddSelectRaw("SUM(amount) AS total").From("orders").GroupBy("user_id")
+					}, "o", "o.user_id", "=", "users.id")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NULL`,
+			expectedSQL:  `SELECT "users"."id", "o"."total" FROM "users" INNER JOIN (SELECT "user_id", SUM(amount) AS total FROM "orders" GROUP BY "user_id") AS "o" ON "o"."user_id" = "users"."id"`,
 			expectedArgs: []any{},
 		},
-
-		// -------------------------------------------
-		// ------------- Where Not Null --------------
-		// -------------------------------------------
 		{
-			name: "should build query with IS NOT NULL operator",
+			name: "should order args as select, join then where",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("users.id").
+					AddSelectRaw("? AS source", "web").
 					From("users").
-					Where("email", "IS NOT NULL")
+					LeftJoinSub(func(qb QueryBuilder) {
+						qb.Select("user_id").From("orders").Where("status", "=", "paid")
+					}, "o", "o.user_id", "=", "users.id").
+					JoinRaw("JOIN teams t ON t.id = users.team_id AND t.plan = ?", "pro").
+					Where("users.active", "=", true)
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NOT NULL`,
-			expectedArgs: []any{},
+			expectedSQL:  `SELECT "users"."id", $1 AS source FROM "users" LEFT JOIN (SELECT "user_id" FROM "orders" WHERE "status" = $2) AS "o" ON "o"."user_id" = "users"."id" JOIN teams t ON t.id = users.team_id AND t.plan = $3 WHERE "users"."active" = $4`,
+			expectedArgs: []any{"web", "paid", "pro", true},
 		},
 		{
-			name: "should build query with IS NOT NULL operator and ignore extra value",
+			name: "should return error when the subquery fails",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("users.id").
 					From("users").
-					Where("email", "IS NOT NULL", 123)
+					JoinSub(func(qb QueryBuilder) {
+						qb.Select("user_id").From("")
+					}, "o", "o.user_id", "=", "users.id")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NOT NULL`,
-			expectedArgs: []any{},
+			expectedError: ErrEmptyTable,
 		},
 	}
 
@@ -2056,7 +2216,7 @@ func TestPostgresDialect_Where(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_OrWhere(t *testing.T) {
+func TestPostgresDialect_Where(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -2067,303 +2227,294 @@ func TestPostgresDialect_OrWhere(t *testing.T) {
 		expectedError error
 	}{
 		// -------------------------------------------
-		// ------------- Or Where Basic --------------
+		// --------------- Where Basic ---------------
 		// -------------------------------------------
 		{
-			name: "should build query with a single OR WHERE clause",
+			name: "should build query with a single basic WHERE clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
-					Where("id", "=", 1).
-					OrWhere("name", "=", "John")
+					Where("id", "=", 1)
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "name" = $2`,
-			expectedArgs: []any{1, "John"},
+			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1`,
+			expectedArgs: []any{1},
 		},
 		{
-			name: "should build query with multiple OR WHERE clauses",
+			name: "should build query with multiple basic where clauses",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
 					Where("status", "=", "active").
-					OrWhere("name", "=", "John").
-					OrWhere("email", "LIKE", "%example.com%")
+					Where("email", "LIKE", "%example.com%")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR "name" = $2 OR "email" LIKE $3`,
-			expectedArgs: []any{"active", "John", "%example.com%"},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 AND "email" LIKE $2`,
+			expectedArgs: []any{"active", "%example.com%"},
 		},
 		{
-			name: "should treat leading OrWhere as first WHERE clause",
+			name: "should compare a column to another column",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
-					From("users").
-					OrWhere("name", "=", "John")
+					From("posts p").
+					Where("p.updated_at", ">", Col("p.created_at"))
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "name" = $1`,
-			expectedArgs: []any{"John"},
+			expectedSQL:  `SELECT * FROM "posts" AS "p" WHERE "p"."updated_at" > "p"."created_at"`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should compare a column to a raw expression",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("status", "=", "active").
+					Where("price", ">", Raw("cost * ?", 1.2)).
+					OrWhere("created_at", "<", Raw("NOW() - interval '1 day'"))
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "status" = $1 AND "price" > cost * $2 OR "created_at" < NOW() - interval '1 day'`,
+			expectedArgs: []any{"active", 1.2},
 		},
 
 		// -------------------------------------------
-		// ------------ Or Where Between -------------
+		// -------------- Where Between --------------
 		// -------------------------------------------
 		{
-			name: "should build query with OR BETWEEN operator and multiple values",
+			name: "should build query with BETWEEN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", "<", 10).
-					OrWhere("price", "BETWEEN", 10, 50)
+					Where("price", "BETWEEN", 10, 50)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" BETWEEN $2 AND $3)`,
-			expectedArgs: []any{10, 10, 50},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
+			expectedArgs: []any{10, 50},
 		},
 		{
-			name: "should build query with OR BETWEEN operator and a slice",
+			name: "should build query with BETWEEN operator and a slice",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", "<", 10).
-					OrWhere("price", "BETWEEN", []int{10, 50})
+					Where("price", "BETWEEN", []int{10, 50})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" BETWEEN $2 AND $3)`,
-			expectedArgs: []any{10, 10, 50},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
+			expectedArgs: []any{10, 50},
 		},
 		{
-			name: "should error when OR BETWEEN operator has a nil 'from' value",
+			name: "should error when BETWEEN operator has a nil 'from' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("id", "=", 1).
-					OrWhere("price", "BETWEEN", nil, 100)
+					Where("price", "BETWEEN", nil, 100)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
 		{
-			name: "should error when OR BETWEEN operator has a nil 'to' value",
+			name: "should error when BETWEEN operator has a nil 'to' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("id", "=", 1).
-					OrWhere("price", "BETWEEN", 50, nil)
+					Where("price", "BETWEEN", 50, nil)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
 
 		// -------------------------------------------
-		// ---------- Or Where Not Between -----------
+		// ------------ Where Not Between ------------
 		// -------------------------------------------
 		{
-			name: "should build query with OR NOT BETWEEN operator and multiple values",
+			name: "should build query with NOT BETWEEN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", "<", 10).
-					OrWhere("price", "NOT BETWEEN", 10, 50)
+					Where("price", "NOT BETWEEN", 10, 50)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" NOT BETWEEN $2 AND $3)`,
-			expectedArgs: []any{10, 10, 50},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
+			expectedArgs: []any{10, 50},
 		},
 		{
-			name: "should build query with OR NOT BETWEEN operator and a slice",
+			name: "should build query with NOT BETWEEN operator and a slice",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", "<", 10).
-					OrWhere("price", "NOT BETWEEN", []int{10, 50})
+					Where("price", "NOT BETWEEN", []int{10, 50})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" NOT BETWEEN $2 AND $3)`,
-			expectedArgs: []any{10, 10, 50},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
+			expectedArgs: []any{10, 50},
 		},
 		{
-			name: "should error when OR NOT BETWEEN operator has a nil 'from' value",
+			name: "should error when NOT BETWEEN operator has a nil 'from' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("id", "=", 1).
-					OrWhere("price", "NOT BETWEEN", nil, 100)
+					Where("price", "NOT BETWEEN", nil, 100)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
 		{
-			name: "should error when OR NOT BETWEEN operator has a nil 'to' value",
+			name: "should error when NOT BETWEEN operator has a nil 'to' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("id", "=", 1).
-					OrWhere("price", "NOT BETWEEN", 50, nil)
+					Where("price", "NOT BETWEEN", 50, nil)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
 
 		// -------------------------------------------
-		// -------------- Or Where In ----------------
+		// ---------------- Where In -----------------
 		// -------------------------------------------
 		{
-			name: "should build query with OR IN operator and a single value",
+			name: "should build query with IN operator and a single value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "IN", 1)
+					Where("category_id", "IN", 1)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" IN ($2)`,
-			expectedArgs: []any{100, 1},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1)`,
+			expectedArgs: []any{1},
 		},
 		{
-			name: "should build query with OR IN operator and multiple values",
+			name: "should build query with IN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "IN", "a", "b", "c")
+					Where("category_id", "IN", "a", "b", "c")
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" IN ($2, $3, $4)`,
-			expectedArgs: []any{100, "a", "b", "c"},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3)`,
+			expectedArgs: []any{"a", "b", "c"},
 		},
 		{
-			name: "should build query with OR IN operator and a slice of values",
+			name: "should build query with IN operator and a slice of values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "IN", []int{1, 2, 3})
+					Where("category_id", "IN", []int{1, 2, 3})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" IN ($2, $3, $4)`,
-			expectedArgs: []any{100, 1, 2, 3},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3)`,
+			expectedArgs: []any{1, 2, 3},
 		},
 		{
-			name: "should build query with OR IN operator and mixed slices",
+			name: "should build query with IN operator and mixed slices",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "NOT IN", []int{1, 2, 3}, []string{"a", "b", "c"})
+					Where("category_id", "IN", []int{1, 2, 3}, []string{"a", "b", "c"})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4, $5, $6, $7)`,
-			expectedArgs: []any{100, 1, 2, 3, "a", "b", "c"},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3, $4, $5, $6)`,
+			expectedArgs: []any{1, 2, 3, "a", "b", "c"},
 		},
 
 		// -------------------------------------------
-		// ------------ Or Where Not In --------------
+		// -------------- Where Not In ---------------
 		// -------------------------------------------
 		{
-			name: "should build query with OR NOT IN operator and a single value",
+			name: "should build query with NOT IN operator and a single value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "NOT IN", 1)
+					Where("category_id", "NOT IN", 1)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2)`,
-			expectedArgs: []any{100, 1},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1)`,
+			expectedArgs: []any{1},
 		},
 		{
-			name: "should build query with OR NOT IN operator and multiple values",
+			name: "should build query with NOT IN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "NOT IN", "a", "b", "c")
+					Where("category_id", "NOT IN", "a", "b", "c")
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4)`,
-			expectedArgs: []any{100, "a", "b", "c"},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1, $2, $3)`,
+			expectedArgs: []any{"a", "b", "c"},
 		},
 		{
-			name: "should build query with OR NOT IN operator and a slice of values",
+			name: "should build query with NOT IN operator and a slice of values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "NOT IN", []int{1, 2, 3})
+					Where("category_id", "NOT IN", []int{1, 2, 3})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4)`,
-			expectedArgs: []any{100, 1, 2, 3},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1, $2, $3)`,
+			expectedArgs: []any{1, 2, 3},
 		},
 		{
-			name: "should build query with OR NOT IN operator and mixed slices",
+			name: "should build query with NOT IN operator and mixed slices",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("price", ">", 100).
-					OrWhere("category_id", "NOT IN", []int{1, 2, 3}, []string{"a", "b", "c"})
+					Where("category_id", "NOT IN", []int{1, 2, 3}, []string{"a", "b", "c"})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4, $5, $6, $7)`,
-			expectedArgs: []any{100, 1, 2, 3, "a", "b", "c"},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" NOT IN ($1, $2, $3, $4, $5, $6)`,
+			expectedArgs: []any{1, 2, 3, "a", "b", "c"},
 		},
 
 		// -------------------------------------------
-		// ------------- Or Where Null ---------------
+		// --------------- Where Null ----------------
 		// -------------------------------------------
 		{
-			name: "should build query with OR IS NULL operator",
+			name: "should build query with IS NULL operator",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
-					Where("id", "=", 1).
-					OrWhere("email", "IS NULL")
+					Where("email", "IS NULL")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NULL`,
-			expectedArgs: []any{1},
+			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NULL`,
+			expectedArgs: []any{},
 		},
 		{
-			name: "should build query with OR IS NULL operator and ignore extra value",
+			name: "should build query with IS NULL operator and ignore extra value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
-					Where("id", "=", 1).
-					OrWhere("email", "IS NULL", 123)
+					Where("email", "IS NULL", 123)
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NULL`,
-			expectedArgs: []any{1},
+			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NULL`,
+			expectedArgs: []any{},
 		},
 
 		// -------------------------------------------
-		// ----------- Or Where Not Null -------------
+		// ------------- Where Not Null --------------
 		// -------------------------------------------
 		{
-			name: "should build query with OR IS NOT NULL operator",
+			name: "should build query with IS NOT NULL operator",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
-					Where("id", "=", 1).
-					OrWhere("email", "IS NOT NULL")
+					Where("email", "IS NOT NULL")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NOT NULL`,
-			expectedArgs: []any{1},
+			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NOT NULL`,
+			expectedArgs: []any{},
 		},
 		{
-			name: "should build query with OR IS NOT NULL operator and ignore extra value",
+			name: "should build query with IS NOT NULL operator and ignore extra value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
-					Where("id", "=", 1).
-					OrWhere("email", "IS NOT NULL", 123)
+					Where("email", "IS NOT NULL", 123)
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NOT NULL`,
-			expectedArgs: []any{1},
+			expectedSQL:  `SELECT * FROM "users" WHERE "email" IS NOT NULL`,
+			expectedArgs: []any{},
 		},
 	}
 
@@ -2385,7 +2536,7 @@ func TestPostgresDialect_OrWhere(t *testing.T) {
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -2398,7 +2549,7 @@ func TestPostgresDialect_OrWhere(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_WhereBetween(t *testing.T) {
+func TestPostgresDialect_OrWhere(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -2408,158 +2559,305 @@ func TestPostgresDialect_WhereBetween(t *testing.T) {
 		expectedArgs  []any
 		expectedError error
 	}{
+		// -------------------------------------------
+		// ------------- Or Where Basic --------------
+		// -------------------------------------------
 		{
-			name: "should build single basic between clause",
+			name: "should build query with a single OR WHERE clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("id", "=", 1).
+					OrWhere("name", "=", "John")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "name" = $2`,
+			expectedArgs: []any{1, "John"},
+		},
+		{
+			name: "should build query with multiple OR WHERE clauses",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "active").
+					OrWhere("name", "=", "John").
+					OrWhere("email", "LIKE", "%example.com%")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR "name" = $2 OR "email" LIKE $3`,
+			expectedArgs: []any{"active", "John", "%example.com%"},
+		},
+		{
+			name: "should treat leading OrWhere as first WHERE clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrWhere("name", "=", "John")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "name" = $1`,
+			expectedArgs: []any{"John"},
+		},
+
+		// -------------------------------------------
+		// ------------ Or Where Between -------------
+		// -------------------------------------------
+		{
+			name: "should build query with OR BETWEEN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereBetween("price", 10, 100)
+					Where("price", "<", 10).
+					OrWhere("price", "BETWEEN", 10, 50)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
-			expectedArgs: []any{10, 100},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" BETWEEN $2 AND $3)`,
+			expectedArgs: []any{10, 10, 50},
 		},
 		{
-			name: "should build multiple basic between clauses",
+			name: "should build query with OR BETWEEN operator and a slice",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereBetween("price", 10, 100).
-					WhereBetween("weight", 1, 5)
+					Where("price", "<", 10).
+					OrWhere("price", "BETWEEN", []int{10, 50})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2) AND ("weight" BETWEEN $3 AND $4)`,
-			expectedArgs: []any{10, 100, 1, 5},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" BETWEEN $2 AND $3)`,
+			expectedArgs: []any{10, 10, 50},
 		},
 		{
-			name: "should return error when 'from' value is nil",
+			name: "should error when OR BETWEEN operator has a nil 'from' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereBetween("category_id", nil, 100)
+					Where("id", "=", 1).
+					OrWhere("price", "BETWEEN", nil, 100)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
 		{
-			name: "should return error when 'to' value is nil",
+			name: "should error when OR BETWEEN operator has a nil 'to' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereBetween("category_id", 50, nil)
+					Where("id", "=", 1).
+					OrWhere("price", "BETWEEN", 50, nil)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			// Arrange
-			b := &builder{
-				dialect: PostgresDialect{},
-				limit:   -1,
-				offset:  -1,
-			}
-			tt.build(b)
-
-			// Act
-			sql, args, err := b.dialect.CompileSelect(b)
-
-			// Assert
-			if tt.expectedError != nil {
-				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
-				assert.Empty(t, sql, "expected empty SQL on error")
-				assert.Empty(t, args, "expected empty args on error")
-				return
-			}
-
-			assert.NoError(t, err, "expected no error")
-			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
-			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
-		})
-	}
-}
 
-func TestPostgresDialect_OrWhereBetween(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name          string
-		build         func(*builder) QueryBuilder
-		expectedSQL   string
-		expectedArgs  []any
-		expectedError error
-	}{
+		// -------------------------------------------
+		// ---------- Or Where Not Between -----------
+		// -------------------------------------------
 		{
-			name: "should build single OR between clause",
+			name: "should build query with OR NOT BETWEEN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("quantity", ">", 5).
-					OrWhereBetween("price", 10, 100)
+					Where("price", "<", 10).
+					OrWhere("price", "NOT BETWEEN", 10, 50)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" BETWEEN $2 AND $3)`,
-			expectedArgs: []any{5, 10, 100},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" NOT BETWEEN $2 AND $3)`,
+			expectedArgs: []any{10, 10, 50},
 		},
 		{
-			name: "should build multiple OR between clause",
+			name: "should build query with OR NOT BETWEEN operator and a slice",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					Where("quantity", ">", 5).
-					OrWhereBetween("price", 10, 100).
-					OrWhereBetween("weight", 1, 5)
+					Where("price", "<", 10).
+					OrWhere("price", "NOT BETWEEN", []int{10, 50})
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" BETWEEN $2 AND $3) OR ("weight" BETWEEN $4 AND $5)`,
-			expectedArgs: []any{5, 10, 100, 1, 5},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" < $1 OR ("price" NOT BETWEEN $2 AND $3)`,
+			expectedArgs: []any{10, 10, 50},
 		},
 		{
-			name: "should treat leading OrWhereBetween as first WHERE clause",
+			name: "should error when OR NOT BETWEEN operator has a nil 'from' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					OrWhereBetween("price", 10, 100)
+					Where("id", "=", 1).
+					OrWhere("price", "NOT BETWEEN", nil, 100)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
-			expectedArgs: []any{10, 100},
+			expectedError: ErrNilNotAllowed,
 		},
 		{
-			name: "should return error when column name is empty",
+			name: "should error when OR NOT BETWEEN operator has a nil 'to' value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					OrWhereBetween("", 10, 100)
+					Where("id", "=", 1).
+					OrWhere("price", "NOT BETWEEN", 50, nil)
 			},
-			expectedError: ErrEmptyColumn,
+			expectedError: ErrNilNotAllowed,
 		},
+
+		// -------------------------------------------
+		// -------------- Or Where In ----------------
+		// -------------------------------------------
 		{
-			name: "should return error when 'from' value is nil",
+			name: "should build query with OR IN operator and a single value",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					OrWhereBetween("category_id", nil, 100)
+					Where("price", ">", 100).
+					OrWhere("category_id", "IN", 1)
 			},
-			expectedError: ErrNilNotAllowed,
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" IN ($2)`,
+			expectedArgs: []any{100, 1},
 		},
 		{
-			name: "should return error when 'to' value is nil",
+			name: "should build query with OR IN operator and multiple values",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					OrWhereBetween("category_id", 50, nil)
+					Where("price", ">", 100).
+					OrWhere("category_id", "IN", "a", "b", "c")
 			},
-			expectedError: ErrNilNotAllowed,
-		},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" IN ($2, $3, $4)`,
+			expectedArgs: []any{100, "a", "b", "c"},
+		},
+		{
+			name: "should build query with OR IN operator and a slice of values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("price", ">", 100).
+					OrWhere("category_id", "IN", []int{1, 2, 3})
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" IN ($2, $3, $4)`,
+			expectedArgs: []any{100, 1, 2, 3},
+		},
+		{
+			name: "should build query with OR IN operator and mixed slices",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("price", ">", 100).
+					OrWhere("category_id", "NOT IN", []int{1, 2, 3}, []string{"a", "b", "c"})
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4, $5, $6, $7)`,
+			expectedArgs: []any{100, 1, 2, 3, "a", "b", "c"},
+		},
+
+		// -------------------------------------------
+		// ------------ Or Where Not In --------------
+		// -------------------------------------------
+		{
+			name: "should build query with OR NOT IN operator and a single value",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("price", ">", 100).
+					OrWhere("category_id", "NOT IN", 1)
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2)`,
+			expectedArgs: []any{100, 1},
+		},
+		{
+			name: "should build query with OR NOT IN operator and multiple values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("price", ">", 100).
+					OrWhere("category_id", "NOT IN", "a", "b", "c")
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4)`,
+			expectedArgs: []any{100, "a", "b", "c"},
+		},
+		{
+			name: "should build query with OR NOT IN operator and a slice of values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("price", ">", 100).
+					OrWhere("category_id", "NOT IN", []int{1, 2, 3})
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4)`,
+			expectedArgs: []any{100, 1, 2, 3},
+		},
+		{
+			name: "should build query with OR NOT IN operator and mixed slices",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("price", ">", 100).
+					OrWhere("category_id", "NOT IN", []int{1, 2, 3}, []string{"a", "b", "c"})
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "price" > $1 OR "category_id" NOT IN ($2, $3, $4, $5, $6, $7)`,
+			expectedArgs: []any{100, 1, 2, 3, "a", "b", "c"},
+		},
+
+		// -------------------------------------------
+		// ------------- Or Where Null ---------------
+		// -------------------------------------------
+		{
+			name: "should build query with OR IS NULL operator",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("id", "=", 1).
+					OrWhere("email", "IS NULL")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NULL`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build query with OR IS NULL operator and ignore extra value",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("id", "=", 1).
+					OrWhere("email", "IS NULL", 123)
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NULL`,
+			expectedArgs: []any{1},
+		},
+
+		// -------------------------------------------
+		// ----------- Or Where Not Null -------------
+		// -------------------------------------------
+		{
+			name: "should build query with OR IS NOT NULL operator",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("id", "=", 1).
+					OrWhere("email", "IS NOT NULL")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NOT NULL`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build query with OR IS NOT NULL operator and ignore extra value",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("id", "=", 1).
+					OrWhere("email", "IS NOT NULL", 123)
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1 OR "email" IS NOT NULL`,
+			expectedArgs: []any{1},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2593,7 +2891,7 @@ func TestPostgresDialect_OrWhereBetween(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_WhereNotBetween(t *testing.T) {
+func TestPostgresDialect_WhereBetween(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -2604,45 +2902,35 @@ func TestPostgresDialect_WhereNotBetween(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build single basic not between clause",
+			name: "should build single basic between clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereNotBetween("price", 10, 100)
+					WhereBetween("price", 10, 100)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
 			expectedArgs: []any{10, 100},
 		},
 		{
-			name: "should build multiple basic not between clauses",
+			name: "should build multiple basic between clauses",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereNotBetween("price", 10, 100).
-					WhereNotBetween("weight", 1, 5)
+					WhereBetween("price", 10, 100).
+					WhereBetween("weight", 1, 5)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2) AND ("weight" NOT BETWEEN $3 AND $4)`,
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2) AND ("weight" BETWEEN $3 AND $4)`,
 			expectedArgs: []any{10, 100, 1, 5},
 		},
-		{
-			name: "should return error when column name is empty",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("products").
-					WhereNotBetween("", 10, 100)
-			},
-			expectedError: ErrEmptyColumn,
-		},
 		{
 			name: "should return error when 'from' value is nil",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereNotBetween("category_id", nil, 100)
+					WhereBetween("category_id", nil, 100)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
@@ -2652,7 +2940,7 @@ func TestPostgresDialect_WhereNotBetween(t *testing.T) {
 				return b.
 					Select().
 					From("products").
-					WhereNotBetween("category_id", 50, nil)
+					WhereBetween("category_id", 50, nil)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
@@ -2689,7 +2977,7 @@ func TestPostgresDialect_WhereNotBetween(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
+func TestPostgresDialect_OrWhereBetween(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -2700,39 +2988,39 @@ func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build single OR not between clause",
+			name: "should build single OR between clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
 					Where("quantity", ">", 5).
-					OrWhereNotBetween("price", 10, 100)
+					OrWhereBetween("price", 10, 100)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" NOT BETWEEN $2 AND $3)`,
+			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" BETWEEN $2 AND $3)`,
 			expectedArgs: []any{5, 10, 100},
 		},
 		{
-			name: "should build multiple OR not between clause",
+			name: "should build multiple OR between clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
 					Where("quantity", ">", 5).
-					OrWhereNotBetween("price", 10, 100).
-					OrWhereNotBetween("weight", 1, 5)
+					OrWhereBetween("price", 10, 100).
+					OrWhereBetween("weight", 1, 5)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" NOT BETWEEN $2 AND $3) OR ("weight" NOT BETWEEN $4 AND $5)`,
+			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" BETWEEN $2 AND $3) OR ("weight" BETWEEN $4 AND $5)`,
 			expectedArgs: []any{5, 10, 100, 1, 5},
 		},
 		{
-			name: "should treat leading OrWhereNotBetween as first WHERE clause",
+			name: "should treat leading OrWhereBetween as first WHERE clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					OrWhereNotBetween("price", 10, 100)
+					OrWhereBetween("price", 10, 100)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" BETWEEN $1 AND $2)`,
 			expectedArgs: []any{10, 100},
 		},
 		{
@@ -2741,7 +3029,7 @@ func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
 				return b.
 					Select().
 					From("products").
-					OrWhereNotBetween("", 10, 100)
+					OrWhereBetween("", 10, 100)
 			},
 			expectedError: ErrEmptyColumn,
 		},
@@ -2751,7 +3039,7 @@ func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
 				return b.
 					Select().
 					From("products").
-					OrWhereNotBetween("category_id", nil, 100)
+					OrWhereBetween("category_id", nil, 100)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
@@ -2761,7 +3049,7 @@ func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
 				return b.
 					Select().
 					From("products").
-					OrWhereNotBetween("category_id", 50, nil)
+					OrWhereBetween("category_id", 50, nil)
 			},
 			expectedError: ErrNilNotAllowed,
 		},
@@ -2798,7 +3086,7 @@ func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_WhereIn(t *testing.T) {
+func TestPostgresDialect_WhereNotBetween(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -2809,77 +3097,282 @@ func TestPostgresDialect_WhereIn(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build IN clause with single value",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
-					From("customers").
-					WhereIn("id", 1)
-			},
-			expectedSQL:  `SELECT * FROM "customers" WHERE "id" IN ($1)`,
-			expectedArgs: []any{1},
-		},
-		{
-			name: "should build IN clause with multiple values",
+			name: "should build single basic not between clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
-					From("orders").
-					WhereIn("id", 101, 102, 103)
+					From("products").
+					WhereNotBetween("price", 10, 100)
 			},
-			expectedSQL:  `SELECT * FROM "orders" WHERE "id" IN ($1, $2, $3)`,
-			expectedArgs: []any{101, 102, 103},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
+			expectedArgs: []any{10, 100},
 		},
 		{
-			name: "should build multiple IN clause",
+			name: "should build multiple basic not between clauses",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereIn("category_id", 1, 2).
-					WhereIn("status", "active", "pending")
+					WhereNotBetween("price", 10, 100).
+					WhereNotBetween("weight", 1, 5)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2) AND "status" IN ($3, $4)`,
-			expectedArgs: []any{1, 2, "active", "pending"},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2) AND ("weight" NOT BETWEEN $3 AND $4)`,
+			expectedArgs: []any{10, 100, 1, 5},
 		},
 		{
-			name: "should build IN clause from single slice",
+			name: "should return error when column name is empty",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("products").
-					WhereIn("category_id", []int{11, 12, 13})
+					WhereNotBetween("", 10, 100)
 			},
-			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3)`,
-			expectedArgs: []any{11, 12, 13},
+			expectedError: ErrEmptyColumn,
 		},
 		{
-			name: "should build IN clause from multiple slices",
+			name: "should return error when 'from' value is nil",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
-					From("employees").
-					WhereIn("department_id", []int{1, 2}, []string{"HR", "Finance"})
+					From("products").
+					WhereNotBetween("category_id", nil, 100)
 			},
-			expectedSQL:  `SELECT * FROM "employees" WHERE "department_id" IN ($1, $2, $3, $4)`,
-			expectedArgs: []any{1, 2, "HR", "Finance"},
+			expectedError: ErrNilNotAllowed,
 		},
 		{
-			name: "should build IN clause from mixed values and slice",
+			name: "should return error when 'to' value is nil",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
-					From("invoices").
-					WhereIn("status", "paid", []string{"pending", "overdue"})
+					From("products").
+					WhereNotBetween("category_id", 50, nil)
 			},
-			expectedSQL:  `SELECT * FROM "invoices" WHERE "status" IN ($1, $2, $3)`,
-			expectedArgs: []any{"paid", "pending", "overdue"},
+			expectedError: ErrNilNotAllowed,
 		},
-		{
-			name: "should build IN clause with boolean values",
-			build: func(b *builder) QueryBuilder {
-				return b.
-					Select().
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_OrWhereNotBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build single OR not between clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("quantity", ">", 5).
+					OrWhereNotBetween("price", 10, 100)
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" NOT BETWEEN $2 AND $3)`,
+			expectedArgs: []any{5, 10, 100},
+		},
+		{
+			name: "should build multiple OR not between clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					Where("quantity", ">", 5).
+					OrWhereNotBetween("price", 10, 100).
+					OrWhereNotBetween("weight", 1, 5)
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "quantity" > $1 OR ("price" NOT BETWEEN $2 AND $3) OR ("weight" NOT BETWEEN $4 AND $5)`,
+			expectedArgs: []any{5, 10, 100, 1, 5},
+		},
+		{
+			name: "should treat leading OrWhereNotBetween as first WHERE clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					OrWhereNotBetween("price", 10, 100)
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE ("price" NOT BETWEEN $1 AND $2)`,
+			expectedArgs: []any{10, 100},
+		},
+		{
+			name: "should return error when column name is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					OrWhereNotBetween("", 10, 100)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when 'from' value is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					OrWhereNotBetween("category_id", nil, 100)
+			},
+			expectedError: ErrNilNotAllowed,
+		},
+		{
+			name: "should return error when 'to' value is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					OrWhereNotBetween("category_id", 50, nil)
+			},
+			expectedError: ErrNilNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_WhereIn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build IN clause with single value",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("customers").
+					WhereIn("id", 1)
+			},
+			expectedSQL:  `SELECT * FROM "customers" WHERE "id" IN ($1)`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build IN clause with multiple values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("orders").
+					WhereIn("id", 101, 102, 103)
+			},
+			expectedSQL:  `SELECT * FROM "orders" WHERE "id" IN ($1, $2, $3)`,
+			expectedArgs: []any{101, 102, 103},
+		},
+		{
+			name: "should build multiple IN clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					WhereIn("category_id", 1, 2).
+					WhereIn("status", "active", "pending")
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2) AND "status" IN ($3, $4)`,
+			expectedArgs: []any{1, 2, "active", "pending"},
+		},
+		{
+			name: "should build IN clause from single slice",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("products").
+					WhereIn("category_id", []int{11, 12, 13})
+			},
+			expectedSQL:  `SELECT * FROM "products" WHERE "category_id" IN ($1, $2, $3)`,
+			expectedArgs: []any{11, 12, 13},
+		},
+		{
+			name: "should build IN clause from multiple slices",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("employees").
+					WhereIn("department_id", []int{1, 2}, []string{"HR", "Finance"})
+			},
+			expectedSQL:  `SELECT * FROM "employees" WHERE "department_id" IN ($1, $2, $3, $4)`,
+			expectedArgs: []any{1, 2, "HR", "Finance"},
+		},
+		{
+			name: "should build IN clause from mixed values and slice",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("invoices").
+					WhereIn("status", "paid", []string{"pending", "overdue"})
+			},
+			expectedSQL:  `SELECT * FROM "invoices" WHERE "status" IN ($1, $2, $3)`,
+			expectedArgs: []any{"paid", "pending", "overdue"},
+		},
+		{
+			name: "should build IN clause with boolean values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
 					From("accounts").
 					WhereIn("is_verified", true, false)
 			},
@@ -3927,44 +4420,110 @@ func TestPostgresDialect_WhereRaw(t *testing.T) {
 			expectedArgs: []any{"2023-01-01", 25},
 		},
 		{
-			name: "should return error when raw query is empty",
+			name: "should keep question marks inside strings, identifiers and comments",
 			build: func(b *builder) QueryBuilder {
 				return b.
 					Select().
 					From("users").
-					WhereRaw("")
+					WhereRaw(`note <> 'why?' AND "odd?col" = ? /* any? */`, 1).
+					WhereRaw("body <> $$is it?$$ -- really?\n AND age > ?", 25)
 			},
-			expectedError: ErrEmptyExpression,
+			expectedSQL:  `SELECT * FROM "users" WHERE note <> 'why?' AND "odd?col" = $1 /* any? */ AND body <> $$is it?$$ -- really?` + "\n" + ` AND age > $2`,
+			expectedArgs: []any{1, 25},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			// Arrange
-			b := &builder{
-				dialect: PostgresDialect{},
-				limit:   -1,
-				offset:  -1,
-			}
-			tt.build(b)
-
-			// Act
-			sql, args, err := b.dialect.CompileSelect(b)
-
-			// Assert
-			if tt.expectedError != nil {
-				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
-				assert.Empty(t, sql, "expected empty SQL on error")
-				assert.Empty(t, args, "expected empty args on error")
-				return
-			}
-
-			assert.NoError(t, err, "expected no error")
-			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
-			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		{
+			name: "should keep JSONB operators and unescape ??",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereRaw("tags ?| ? AND tags ?& ? AND meta ?? ?", "a", "b", "key")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE tags ?| $1 AND tags ?& $2 AND meta ? $3`,
+			expectedArgs: []any{"a", "b", "key"},
+		},
+		{
+			name: "should return error when placeholders and args disagree",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereRaw("age > ? AND age < ?", 18)
+			},
+			expectedError: ErrArgCountMismatch,
+		},
+		{
+			name: "should bind named args and reuse the placeholder of a repeated name",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("active", "=", true).
+					WhereRaw("created_at > :since AND (owner = :owner OR editor = :owner) AND kind::text = 'a'", Named("since", "2025-01-01"), Named("owner", "ann"))
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "active" = $1 AND created_at > $2 AND (owner = $3 OR editor = $3) AND kind::text = 'a'`,
+			expectedArgs: []any{true, "2025-01-01", "ann"},
+		},
+		{
+			name: "should bind named args from a map",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereRaw("age BETWEEN :min AND :max", map[string]any{"min": 20, "max": 30})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE age BETWEEN $1 AND $2`,
+			expectedArgs: []any{20, 30},
+		},
+		{
+			name: "should return error when a named arg is missing",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereRaw("age > :min", Named("max", 30))
+			},
+			expectedError: ErrMissingNamedArg,
+		},
+		{
+			name: "should return error when raw query is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereRaw("")
+			},
+			expectedError: ErrEmptyExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
 		})
 	}
 }
@@ -4421,6 +4980,23 @@ func TestPostgresDialect_WhereSub(t *testing.T) {
 			expectedSQL:  `SELECT * FROM "users" WHERE "id" IN (SELECT "user_id" FROM "orders" WHERE "amount" > $1)`,
 			expectedArgs: []any{100},
 		},
+		{
+			name: "should keep literal placeholders inside subquery strings untouched",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("active", "=", true).
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").
+							From("notes").
+							WhereRaw("body <> '$1'").
+							Where("amount", ">", 100)
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "active" = $1 AND "id" IN (SELECT "user_id" FROM "notes" WHERE body <> '$1' AND "amount" > $2)`,
+			expectedArgs: []any{true, 100},
+		},
 		{
 			name: "should build where clause with subquery and alias",
 			build: func(b *builder) QueryBuilder {
@@ -4876,67 +5452,1334 @@ func TestPostgresDialect_OrWhereExists(t *testing.T) {
 							Where("amount", ">", 100)
 					})
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND "amount" > $2)`,
-			expectedArgs: []any{"inactive", 100},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND "amount" > $2)`,
+			expectedArgs: []any{"inactive", 100},
+		},
+		{
+			name: "should treat leading OrWhereExists as first WHERE clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrWhereExists(func(q QueryBuilder) {
+						q.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id")
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build deeply nested OR EXISTS subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereExists(func(q1 QueryBuilder) {
+						q1.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id").
+							OrWhereExists(func(q2 QueryBuilder) {
+								q2.SelectRaw("1").
+									From("order_items").
+									WhereRaw("order_items.order_id = orders.id").
+									Where("product_id", "=", 1)
+							})
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id OR EXISTS (SELECT 1 FROM "order_items" WHERE order_items.order_id = orders.id AND "product_id" = $2))`,
+			expectedArgs: []any{"inactive", 1},
+		},
+		{
+			name: "should return error when subquery builder is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereExists(nil)
+			},
+			expectedError: ErrNilFunc,
+		},
+		{
+			name: "should return error when child query error",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereExists(func(q QueryBuilder) {
+						q.Select("user_id").From("")
+					})
+			},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_WhereNotExists(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build NOT EXISTS clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereNotExists(func(q QueryBuilder) {
+						q.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id")
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build NOT EXISTS clause with other conditions",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "active").
+					WhereNotExists(func(q QueryBuilder) {
+						q.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id").
+							Where("amount", ">", 100)
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 AND NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND "amount" > $2)`,
+			expectedArgs: []any{"active", 100},
+		},
+		{
+			name: "should build deeply nested NOT EXISTS subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereNotExists(func(q1 QueryBuilder) {
+						q1.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id").
+							WhereNotExists(func(q2 QueryBuilder) {
+								q2.SelectRaw("1").
+									From("order_items").
+									WhereRaw("order_items.order_id = orders.id").
+									Where("product_id", "=", 1)
+							})
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND NOT EXISTS (SELECT 1 FROM "order_items" WHERE order_items.order_id = orders.id AND "product_id" = $1))`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should return error when subquery builder is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereNotExists(nil)
+			},
+			expectedError: ErrNilFunc,
+		},
+		{
+			name: "should return error when child query error",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					WhereNotExists(func(q QueryBuilder) {
+						q.Select("user_id").From("")
+					})
+			},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_OrWhereNotExists(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build OR NOT EXISTS clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereNotExists(func(q QueryBuilder) {
+						q.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id")
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
+			expectedArgs: []any{"inactive"},
+		},
+		{
+			name: "should build OR NOT EXISTS clause with other conditions",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereNotExists(func(q QueryBuilder) {
+						q.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id").
+							Where("amount", ">", 100)
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND "amount" > $2)`,
+			expectedArgs: []any{"inactive", 100},
+		},
+		{
+			name: "should treat leading OrWhereNotExists as first WHERE clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrWhereNotExists(func(q QueryBuilder) {
+						q.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id")
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build deeply nested OR NOT EXISTS subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereNotExists(func(q1 QueryBuilder) {
+						q1.SelectRaw("1").
+							From("orders").
+							WhereRaw("orders.user_id = users.id").
+							OrWhereNotExists(func(q2 QueryBuilder) {
+								q2.SelectRaw("1").
+									From("order_items").
+									WhereRaw("order_items.order_id = orders.id").
+									Where("product_id", "=", 1)
+							})
+					})
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id OR NOT EXISTS (SELECT 1 FROM "order_items" WHERE order_items.order_id = orders.id AND "product_id" = $2))`,
+			expectedArgs: []any{"inactive", 1},
+		},
+		{
+			name: "should return error when subquery builder is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereNotExists(nil)
+			},
+			expectedError: ErrNilFunc,
+		},
+		{
+			name: "should return error when child query error",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					Where("status", "=", "inactive").
+					OrWhereNotExists(func(q QueryBuilder) {
+						q.Select("user_id").From("")
+					})
+			},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_OrderBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build single order by clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrderBy("name", "ASC")
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY "name" ASC`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build multiple order by clauses",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrderBy("name", "ASC").
+					OrderBy("age", "DESC")
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY "name" ASC, "age" DESC`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error when column name is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrderBy("", "ASC")
+			},
+			expectedError: ErrEmptyColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_OrderByRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build single raw order by clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrderByRaw("LENGTH(name) DESC")
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY LENGTH(name) DESC`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build multiple raw order by clauses with args",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrderByRaw("LENGTH(name) ASC").
+					OrderByRaw("CASE WHEN status = ? THEN 1 ELSE 2 END", "active")
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY LENGTH(name) ASC, CASE WHEN status = $1 THEN 1 ELSE 2 END`,
+			expectedArgs: []any{"active"},
+		},
+		{
+			name: "should return error when raw expression is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					From("users").
+					OrderByRaw("")
+			},
+			expectedError: ErrEmptyExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_OrderBySafe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build order by clause with valid whitelist entry",
+			build: func(b *builder) QueryBuilder {
+				whitelist := map[string]string{
+					"name": "users.name",
+				}
+				return b.
+					Select().
+					From("users").
+					OrderBySafe("name", "ASC", whitelist)
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY "users"."name" ASC`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should default to ASC when direction is invalid",
+			build: func(b *builder) QueryBuilder {
+				whitelist := map[string]string{
+					"age": "users.age",
+				}
+				return b.
+					Select().
+					From("users").
+					OrderBySafe("age", "INVALID", whitelist)
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY "users"."age" ASC`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should ignore when user input not in whitelist",
+			build: func(b *builder) QueryBuilder {
+				whitelist := map[string]string{
+					"name": "users.name",
+				}
+				return b.
+					Select().
+					From("users").
+					OrderBySafe("email", "DESC", whitelist)
+			},
+			expectedSQL:  `SELECT * FROM "users"`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should allow DESC when provided correctly",
+			build: func(b *builder) QueryBuilder {
+				whitelist := map[string]string{
+					"created_at": "users.created_at",
+				}
+				return b.
+					Select().
+					From("users").
+					OrderBySafe("created_at", "DESC", whitelist)
+			},
+			expectedSQL:  `SELECT * FROM "users" ORDER BY "users"."created_at" DESC`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error when mapped column is empty",
+			build: func(b *builder) QueryBuilder {
+				whitelist := map[string]string{
+					"age": "",
+				}
+				return b.
+					Select().
+					From("users").
+					OrderBySafe("age", "ASC", whitelist)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		table         string
+		limit         int
+		offset        int
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError string
+	}{
+		{
+			name:         "should build select with limit",
+			table:        "users",
+			limit:        10,
+			offset:       -1, // default
+			expectedSQL:  `SELECT * FROM "users" LIMIT 10`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with offset",
+			table:        "users",
+			limit:        -1, // default
+			offset:       5,
+			expectedSQL:  `SELECT * FROM "users" OFFSET 5`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with limit and offset",
+			table:        "users",
+			limit:        10,
+			offset:       5,
+			expectedSQL:  `SELECT * FROM "users" LIMIT 10 OFFSET 5`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should ignore negative limit and offset",
+			table:        "users",
+			limit:        -10,
+			offset:       -5,
+			expectedSQL:  `SELECT * FROM "users"`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should handle zero limit and offset",
+			table:        "users",
+			limit:        0,
+			offset:       0,
+			expectedSQL:  `SELECT * FROM "users" LIMIT 0 OFFSET 0`,
+			expectedArgs: []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				action:  "select",
+				table: table{
+					queryType: QueryBasic,
+					name:      tt.table,
+				},
+				limit:  tt.limit,
+				offset: tt.offset,
+			}
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			if tt.expectedError != "" {
+				assert.Error(t, err, "expected an error")
+				assert.Contains(t, err.Error(), tt.expectedError, "expected error message to contain output")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_Insert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build insert with a single row",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name", "email").
+					Values("John", "john@example.com")
+			},
+			expectedSQL:  `INSERT INTO "users" ("name", "email") VALUES ($1, $2)`,
+			expectedArgs: []any{"John", "john@example.com"},
+		},
+		{
+			name: "should build insert with raw and column values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name", "slug", "created_at", "updated_at").
+					Values("John", Raw("lower(?)", "John"), Raw("NOW()"), Col("created_at"))
+			},
+			expectedSQL:  `INSERT INTO "users" ("name", "slug", "created_at", "updated_at") VALUES ($1, lower($2), NOW(), "created_at")`,
+			expectedArgs: []any{"John", "John"},
+		},
+		{
+			name: "should build insert with multiple rows",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name", "age").
+					Values("John", 30).
+					Values("Jane", 25).
+					Values("Bob", 40)
+			},
+			expectedSQL:  `INSERT INTO "users" ("name", "age") VALUES ($1, $2), ($3, $4), ($5, $6)`,
+			expectedArgs: []any{"John", 30, "Jane", 25, "Bob", 40},
+		},
+		{
+			name: "should build insert with rows passed as slices",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("id", "name").
+					Values([]any{1, "a"}, []any{2, "b"})
+			},
+			expectedSQL:  `INSERT INTO "users" ("id", "name") VALUES ($1, $2), ($3, $4)`,
+			expectedArgs: []any{1, "a", 2, "b"},
+		},
+		{
+			name: "should build insert from maps with sorted columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					ValuesMap(
+						map[string]any{"name": "John", "age": 30},
+						map[string]any{"name": "Jane", "age": 25},
+					)
+			},
+			expectedSQL:  `INSERT INTO "users" ("age", "name") VALUES ($1, $2), ($3, $4)`,
+			expectedArgs: []any{30, "John", 25, "Jane"},
+		},
+		{
+			name: "should build insert without column list",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Values(1, "John")
+			},
+			expectedSQL:  `INSERT INTO "users" VALUES ($1, $2)`,
+			expectedArgs: []any{1, "John"},
+		},
+		{
+			name: "should build insert into schema qualified table",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("public.users").
+					Columns("name").
+					Values("John")
+			},
+			expectedSQL:  `INSERT INTO "public"."users" ("name") VALUES ($1)`,
+			expectedArgs: []any{"John"},
+		},
+		{
+			name: "should bind nil values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name", "deleted_at").
+					Values("John", nil)
+			},
+			expectedSQL:  `INSERT INTO "users" ("name", "deleted_at") VALUES ($1, $2)`,
+			expectedArgs: []any{"John", nil},
+		},
+		{
+			name: "should return error when table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("").
+					Values("John")
+			},
+			expectedError: ErrEmptyTable,
+		},
+		{
+			name: "should return error when no values are given",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name")
+			},
+			expectedError: ErrEmptyValues,
+		},
+		{
+			name: "should return error when row length does not match columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("name", "email").
+					Values("John")
+			},
+			expectedError: ErrColumnMismatch,
+		},
+		{
+			name: "should return error when map rows have different columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					ValuesMap(
+						map[string]any{"name": "John"},
+						map[string]any{"email": "jane@example.com"},
+					)
+			},
+			expectedError: ErrColumnMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileInsert(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build update with a single set and where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("name", "John").
+					Where("id", "=", 1)
+			},
+			expectedSQL:  `UPDATE "users" SET "name" = $1 WHERE "id" = $2`,
+			expectedArgs: []any{"John", 1},
+		},
+		{
+			name: "should build update with raw and column values",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("name", "John").
+					Set("visits", Raw("visits + ?", 1)).
+					Set("updated_at", Raw("NOW()")).
+					Set("nickname", Col("users.name")).
+					Where("id", "=", 1)
+			},
+			expectedSQL:  `UPDATE "users" SET "name" = $1, "visits" = visits + $2, "updated_at" = NOW(), "nickname" = "users"."name" WHERE "id" = $3`,
+			expectedArgs: []any{"John", 1, 1},
+		},
+		{
+			name: "should build update with multiple sets",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("name", "John").
+					Set("email", "john@example.com").
+					Where("id", "=", 1)
+			},
+			expectedSQL:  `UPDATE "users" SET "name" = $1, "email" = $2 WHERE "id" = $3`,
+			expectedArgs: []any{"John", "john@example.com", 1},
+		},
+		{
+			name: "should build update from map in sorted order",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					SetMap(map[string]any{"name": "John", "age": 30}).
+					Where("id", "=", 1)
+			},
+			expectedSQL:  `UPDATE "users" SET "age" = $1, "name" = $2 WHERE "id" = $3`,
+			expectedArgs: []any{30, "John", 1},
+		},
+		{
+			name: "should build update with raw set expression",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("products").
+					SetRaw("stock = stock - ?", 2).
+					Set("updated_by", "admin").
+					Where("id", "=", 10)
+			},
+			expectedSQL:  `UPDATE "products" SET stock = stock - $1, "updated_by" = $2 WHERE "id" = $3`,
+			expectedArgs: []any{2, "admin", 10},
+		},
+		{
+			name: "should build update with nested where groups and subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("status", "inactive").
+					WhereGroup(func(q QueryBuilder) {
+						q.Where("last_login", "<", "2024-01-01").OrWhereNull("last_login")
+					}).
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").From("bans").Where("active", "=", true)
+					})
+			},
+			expectedSQL:  `UPDATE "users" SET "status" = $1 WHERE ("last_login" < $2 OR "last_login" IS NULL) AND "id" IN (SELECT "user_id" FROM "bans" WHERE "active" = $3)`,
+			expectedArgs: []any{"inactive", "2024-01-01", true},
+		},
+		{
+			name: "should build join-update with FROM table",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("orders o").
+					Set("status", "vip").
+					UpdateFrom("customers c").
+					WhereRaw("o.customer_id = c.id").
+					Where("c.tier", "=", "gold")
+			},
+			expectedSQL:  `UPDATE "orders" AS "o" SET "status" = $1 FROM "customers" AS "c" WHERE o.customer_id = c.id AND "c"."tier" = $2`,
+			expectedArgs: []any{"vip", "gold"},
+		},
+		{
+			name: "should build join-update with multiple FROM tables",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("orders").
+					Set("flag", true).
+					UpdateFrom("customers").
+					UpdateFrom("regions").
+					WhereRaw("orders.customer_id = customers.id AND customers.region_id = regions.id")
+			},
+			expectedSQL:  `UPDATE "orders" SET "flag" = $1 FROM "customers", "regions" WHERE orders.customer_id = customers.id AND customers.region_id = regions.id`,
+			expectedArgs: []any{true},
+		},
+		{
+			name: "should build update without where when full table is allowed",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false).
+					AllowFullTable()
+			},
+			expectedSQL:  `UPDATE "users" SET "active" = $1`,
+			expectedArgs: []any{false},
+		},
+		{
+			name: "should build update with only an empty NOT IN when full table is allowed",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false).
+					WhereNotIn("id", []int{}).
+					AllowFullTable()
+			},
+			expectedSQL:  `UPDATE "users" SET "active" = $1 WHERE 1 = 1`,
+			expectedArgs: []any{false},
+		},
+		{
+			name: "should keep filters after an empty NOT IN",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("accounts").
+					Set("x", 1).
+					WhereNotIn("id", []int{}).
+					Where("tenant_id", "=", 5)
+			},
+			expectedSQL:  `UPDATE "accounts" SET "x" = $1 WHERE 1 = 1 AND "tenant_id" = $2`,
+			expectedArgs: []any{1, 5},
+		},
+		{
+			name: "should return error when update has no where clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false)
+			},
+			expectedError: ErrUnfilteredUpdate,
+		},
+		{
+			name: "should return error when update only has an empty NOT IN",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false).
+					WhereNotIn("id", []int{})
+			},
+			expectedError: ErrUnfilteredUpdate,
+		},
+		{
+			name: "should return error when update has an OR branch of empty NOT IN lists",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("active", false).
+					Where("tenant_id", "=", 5).
+					OrWhereNotIn("id", []int{})
+			},
+			expectedError: ErrUnfilteredUpdate,
+		},
+		{
+			name: "should return error when no values are set",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Where("id", "=", 1)
+			},
+			expectedError: ErrEmptyValues,
+		},
+		{
+			name: "should return error when table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("").
+					Set("name", "John").
+					Where("id", "=", 1)
+			},
+			expectedError: ErrEmptyTable,
+		},
+		{
+			name: "should return error when set column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("", "John").
+					Where("id", "=", 1)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error from where subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Update("users").
+					Set("name", "John").
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("id").From("")
+					})
+			},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: PostgresDialect{},
+				limit:   -1,
+				offset:  -1,
+			}
+			tt.build(b)
+
+			// Act
+			sql, args, err := b.dialect.CompileUpdate(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.Error(t, err, "expected an error")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestPostgresDialect_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(*builder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build delete with where",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("users").
+					Where("id", "=", 1)
+			},
+			expectedSQL:  `DELETE FROM "users" WHERE "id" = $1`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build delete using Delete() and From()",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Delete().
+					From("sessions").
+					Where("expires_at", "<", "2024-01-01")
+			},
+			expectedSQL:  `DELETE FROM "sessions" WHERE "expires_at" < $1`,
+			expectedArgs: []any{"2024-01-01"},
+		},
+		{
+			name: "should build delete with multiple conditions and groups",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("users").
+					Where("active", "=", false).
+					WhereGroup(func(q QueryBuilder) {
+						q.WhereNull("email").OrWhereIn("role", "guest", "bot")
+					})
+			},
+			expectedSQL:  `DELETE FROM "users" WHERE "active" = $1 AND ("email" IS NULL OR "role" IN ($2, $3))`,
+			expectedArgs: []any{false, "guest", "bot"},
+		},
+		{
+			name: "should build delete with where subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("orders").
+					WhereNotExists(func(q QueryBuilder) {
+						q.Select("id").From("users").WhereRaw("users.id = orders.user_id").Where("users.active", "=", true)
+					})
+			},
+			expectedSQL:  `DELETE FROM "orders" WHERE NOT EXISTS (SELECT "id" FROM "users" WHERE users.id = orders.user_id AND "users"."active" = $1)`,
+			expectedArgs: []any{true},
+		},
+		{
+			name: "should build joined delete with USING",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("orders o").
+					Using("customers c").
+					WhereRaw("o.customer_id = c.id").
+					Where("c.banned", "=", true)
+			},
+			expectedSQL:  `DELETE FROM "orders" AS "o" USING "customers" AS "c" WHERE o.customer_id = c.id AND "c"."banned" = $1`,
+			expectedArgs: []any{true},
+		},
+		{
+			name: "should build delete with multiple USING tables",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("orders").
+					Using("customers").
+					Using("regions").
+					WhereRaw("orders.customer_id = customers.id AND customers.region_id = regions.id").
+					Where("regions.code", "=", "EU")
+			},
+			expectedSQL:  `DELETE FROM "orders" USING "customers", "regions" WHERE orders.customer_id = customers.id AND customers.region_id = regions.id AND "regions"."code" = $1`,
+			expectedArgs: []any{"EU"},
+		},
+		{
+			name: "should keep filters after an empty NOT IN",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("accounts").
+					WhereNotIn("id", []int{}).
+					Where("tenant_id", "=", 5)
+			},
+			expectedSQL:  `DELETE FROM "accounts" WHERE 1 = 1 AND "tenant_id" = $1`,
+			expectedArgs: []any{5},
+		},
+		{
+			name: "should build delete without where when full table is allowed",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("sessions").
+					AllowFullTable()
+			},
+			expectedSQL:  `DELETE FROM "sessions"`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build delete with only an empty NOT IN when full table is allowed",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("sessions").
+					WhereNotIn("id", []int{}).
+					AllowFullTable()
+			},
+			expectedSQL:  `DELETE FROM "sessions" WHERE 1 = 1`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error when deleting from a subquery",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Delete().
+					FromSub(func(qb QueryBuilder) {
+						qb.Select("id").From("accounts")
+					}, "x").
+					Where("id", "=", 1)
+			},
+			expectedError: ErrInvalidTableInput,
+		},
+		{
+			name: "should return error when deleting from a raw table",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Delete().
+					FromRaw("accounts a").
+					Where("a.id", "=", 1)
+			},
+			expectedError: ErrInvalidTableInput,
+		},
+		{
+			name: "should return error when delete has no where clause",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("users")
+			},
+			expectedError: ErrUnfilteredDelete,
+		},
+		{
+			name: "should return error when delete only has an empty NOT IN",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("users").
+					WhereNotIn("id", []int{})
+			},
+			expectedError: ErrUnfilteredDelete,
 		},
 		{
-			name: "should treat leading OrWhereExists as first WHERE clause",
+			name: "should return error when delete has an OR branch of empty NOT IN lists",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					OrWhereExists(func(q QueryBuilder) {
-						q.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id")
+					DeleteFrom("users").
+					Where("tenant_id", "=", 5).
+					OrWhereGroup(func(q QueryBuilder) {
+						q.WhereNotIn("id", []int{}).WhereNotIn("role", []string{})
 					})
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
-			expectedArgs: []any{},
+			expectedError: ErrUnfilteredDelete,
 		},
 		{
-			name: "should build deeply nested OR EXISTS subquery",
+			name: "should return error when delete has only USING tables and no where clause",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereExists(func(q1 QueryBuilder) {
-						q1.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id").
-							OrWhereExists(func(q2 QueryBuilder) {
-								q2.SelectRaw("1").
-									From("order_items").
-									WhereRaw("order_items.order_id = orders.id").
-									Where("product_id", "=", 1)
-							})
-					})
+					DeleteFrom("orders").
+					Using("customers")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id OR EXISTS (SELECT 1 FROM "order_items" WHERE order_items.order_id = orders.id AND "product_id" = $2))`,
-			expectedArgs: []any{"inactive", 1},
+			expectedError: ErrUnfilteredDelete,
 		},
 		{
-			name: "should return error when subquery builder is nil",
+			name: "should return error when table is missing",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereExists(nil)
+					Delete().
+					Where("id", "=", 1)
 			},
-			expectedError: ErrNilFunc,
+			expectedError: ErrEmptyTable,
 		},
 		{
-			name: "should return error when child query error",
+			name: "should return error when table is empty",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereExists(func(q QueryBuilder) {
-						q.Select("user_id").From("")
-					})
+					DeleteFrom("").
+					Where("id", "=", 1)
+			},
+			expectedError: ErrEmptyTable,
+		},
+		{
+			name: "should return error when USING table is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("orders").
+					Using("").
+					Where("id", "=", 1)
 			},
 			expectedError: ErrEmptyTable,
 		},
@@ -4955,12 +6798,12 @@ func TestPostgresDialect_OrWhereExists(t *testing.T) {
 			tt.build(b)
 
 			// Act
-			sql, args, err := b.dialect.CompileSelect(b)
+			sql, args, err := b.dialect.CompileDelete(b)
 
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -4973,7 +6816,7 @@ func TestPostgresDialect_OrWhereExists(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_WhereNotExists(t *testing.T) {
+func TestPostgresDialect_Returning(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -4984,79 +6827,84 @@ func TestPostgresDialect_WhereNotExists(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build NOT EXISTS clause",
+			name: "should build insert with returning columns",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					WhereNotExists(func(q QueryBuilder) {
-						q.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id")
-					})
+					Insert("users").
+					Columns("name").
+					Values("John").
+					Returning("id", "created_at")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
-			expectedArgs: []any{},
+			expectedSQL:  `INSERT INTO "users" ("name") VALUES ($1) RETURNING "id", "created_at"`,
+			expectedArgs: []any{"John"},
 		},
 		{
-			name: "should build NOT EXISTS clause with other conditions",
+			name: "should build insert returning all columns",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "active").
-					WhereNotExists(func(q QueryBuilder) {
-						q.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id").
-							Where("amount", ">", 100)
-					})
+					Insert("users").
+					Columns("name").
+					Values("John").
+					Returning()
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 AND NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND "amount" > $2)`,
-			expectedArgs: []any{"active", 100},
+			expectedSQL:  `INSERT INTO "users" ("name") VALUES ($1) RETURNING *`,
+			expectedArgs: []any{"John"},
 		},
 		{
-			name: "should build deeply nested NOT EXISTS subquery",
+			name: "should build update with aliased returning column",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					WhereNotExists(func(q1 QueryBuilder) {
-						q1.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id").
-							WhereNotExists(func(q2 QueryBuilder) {
-								q2.SelectRaw("1").
-									From("order_items").
-									WhereRaw("order_items.order_id = orders.id").
-									Where("product_id", "=", 1)
-							})
-					})
+					Update("users").
+					Set("name", "John").
+					Where("id", "=", 1).
+					Returning("id", "name AS new_name")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND NOT EXISTS (SELECT 1 FROM "order_items" WHERE order_items.order_id = orders.id AND "product_id" = $1))`,
-			expectedArgs: []any{1},
+			expectedSQL:  `UPDATE "users" SET "name" = $1 WHERE "id" = $2 RETURNING "id", "name" AS "new_name"`,
+			expectedArgs: []any{"John", 1},
 		},
 		{
-			name: "should return error when subquery builder is nil",
+			name: "should build delete with returning",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					WhereNotExists(nil)
+					DeleteFrom("sessions").
+					Where("expires_at", "<", "2024-01-01").
+					Returning("id")
 			},
-			expectedError: ErrNilFunc,
+			expectedSQL:  `DELETE FROM "sessions" WHERE "expires_at" < $1 RETURNING "id"`,
+			expectedArgs: []any{"2024-01-01"},
 		},
 		{
-			name: "should return error when child query error",
+			name: "should number raw returning args after statement args",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					WhereNotExists(func(q QueryBuilder) {
-						q.Select("user_id").From("")
-					})
+					Update("products").
+					Set("price", 100).
+					Where("id", "=", 7).
+					Returning("id").
+					ReturningRaw("price * ? AS gross", 1.2)
 			},
-			expectedError: ErrEmptyTable,
+			expectedSQL:  `UPDATE "products" SET "price" = $1 WHERE "id" = $2 RETURNING "id", price * $3 AS gross`,
+			expectedArgs: []any{100, 7, 1.2},
+		},
+		{
+			name: "should return error when returning column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Values("John").
+					Returning("")
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when returning expression is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					DeleteFrom("users").
+					Where("id", "=", 1).
+					ReturningRaw("")
+			},
+			expectedError: ErrEmptyExpression,
 		},
 	}
 
@@ -5073,12 +6921,12 @@ func TestPostgresDialect_WhereNotExists(t *testing.T) {
 			tt.build(b)
 
 			// Act
-			sql, args, err := b.dialect.CompileSelect(b)
+			sql, args, err := b.ToSQL()
 
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -5091,7 +6939,7 @@ func TestPostgresDialect_WhereNotExists(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_OrWhereNotExists(t *testing.T) {
+func TestPostgresDialect_Upsert(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -5102,98 +6950,138 @@ func TestPostgresDialect_OrWhereNotExists(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build OR NOT EXISTS clause",
+			name: "should build ON CONFLICT DO NOTHING without target",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereNotExists(func(q QueryBuilder) {
-						q.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id")
-					})
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflict().
+					DoNothing()
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
-			expectedArgs: []any{"inactive"},
+			expectedSQL:  `INSERT INTO "users" ("email") VALUES ($1) ON CONFLICT DO NOTHING`,
+			expectedArgs: []any{"john@example.com"},
 		},
 		{
-			name: "should build OR NOT EXISTS clause with other conditions",
+			name: "should build ON CONFLICT with target DO NOTHING",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereNotExists(func(q QueryBuilder) {
-						q.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id").
-							Where("amount", ">", 100)
-					})
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflict("email").
+					DoNothing()
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id AND "amount" > $2)`,
-			expectedArgs: []any{"inactive", 100},
+			expectedSQL:  `INSERT INTO "users" ("email") VALUES ($1) ON CONFLICT ("email") DO NOTHING`,
+			expectedArgs: []any{"john@example.com"},
 		},
 		{
-			name: "should treat leading OrWhereNotExists as first WHERE clause",
+			name: "should build ON CONFLICT DO UPDATE with raw and column values",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					OrWhereNotExists(func(q QueryBuilder) {
-						q.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id")
-					})
+					Insert("users").
+					Columns("email", "name").
+					Values("john@example.com", "John").
+					OnConflict("email").
+					DoUpdateSet("updated_at", Raw("NOW()")).
+					DoUpdateSet("visits", Raw("users.visits + ?", 1)).
+					DoUpdateSet("nickname", Col("users.name"))
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id)`,
-			expectedArgs: []any{},
+			expectedSQL:  `INSERT INTO "users" ("email", "name") VALUES ($1, $2) ON CONFLICT ("email") DO UPDATE SET "updated_at" = NOW(), "visits" = users.visits + $3, "nickname" = "users"."name"`,
+			expectedArgs: []any{"john@example.com", "John", 1},
 		},
 		{
-			name: "should build deeply nested OR NOT EXISTS subquery",
+			name: "should build ON CONFLICT DO UPDATE with EXCLUDED columns",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereNotExists(func(q1 QueryBuilder) {
-						q1.SelectRaw("1").
-							From("orders").
-							WhereRaw("orders.user_id = users.id").
-							OrWhereNotExists(func(q2 QueryBuilder) {
-								q2.SelectRaw("1").
-									From("order_items").
-									WhereRaw("order_items.order_id = orders.id").
-									Where("product_id", "=", 1)
-							})
-					})
+					Insert("users").
+					Columns("email", "name", "age").
+					Values("john@example.com", "John", 30).
+					Values("jane@example.com", "Jane", 25).
+					OnConflict("email").
+					DoUpdateSetExcluded("name", "age")
 			},
-			expectedSQL:  `SELECT * FROM "users" WHERE "status" = $1 OR NOT EXISTS (SELECT 1 FROM "orders" WHERE orders.user_id = users.id OR NOT EXISTS (SELECT 1 FROM "order_items" WHERE order_items.order_id = orders.id AND "product_id" = $2))`,
-			expectedArgs: []any{"inactive", 1},
+			expectedSQL:  `INSERT INTO "users" ("email", "name", "age") VALUES ($1, $2, $3), ($4, $5, $6) ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name", "age" = EXCLUDED."age"`,
+			expectedArgs: []any{"john@example.com", "John", 30, "jane@example.com", "Jane", 25},
 		},
 		{
-			name: "should return error when subquery builder is nil",
+			name: "should build ON CONFLICT ON CONSTRAINT with bound and raw assignments",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereNotExists(nil)
+					Insert("inventory").
+					Columns("sku", "stock").
+					Values("A-1", 5).
+					OnConflictConstraint("inventory_sku_key").
+					DoUpdateSetRaw(`"stock" = inventory.stock + ?`, 5).
+					DoUpdateSet("source", "import")
 			},
-			expectedError: ErrNilFunc,
+			expectedSQL:  `INSERT INTO "inventory" ("sku", "stock") VALUES ($1, $2) ON CONFLICT ON CONSTRAINT "inventory_sku_key" DO UPDATE SET "stock" = inventory.stock + $3, "source" = $4`,
+			expectedArgs: []any{"A-1", 5, 5, "import"},
 		},
 		{
-			name: "should return error when child query error",
+			name: "should build DO UPDATE with WHERE and RETURNING",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					Where("status", "=", "inactive").
-					OrWhereNotExists(func(q QueryBuilder) {
-						q.Select("user_id").From("")
+					Insert("users").
+					Columns("email", "name", "updated_at").
+					Values("john@example.com", "John", "2024-06-01").
+					OnConflict("email").
+					DoUpdateSetExcluded("name", "updated_at").
+					DoUpdateWhere(func(q QueryBuilder) {
+						q.WhereRaw("users.updated_at < EXCLUDED.updated_at").Where("users.locked", "=", false)
+					}).
+					Returning("id")
+			},
+			expectedSQL:  `INSERT INTO "users" ("email", "name", "updated_at") VALUES ($1, $2, $3) ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name", "updated_at" = EXCLUDED."updated_at" WHERE users.updated_at < EXCLUDED.updated_at AND "users"."locked" = $4 RETURNING "id"`,
+			expectedArgs: []any{"john@example.com", "John", "2024-06-01", false},
+		},
+		{
+			name: "should return error when DO UPDATE has no conflict target",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflict().
+					DoUpdateSetExcluded("email")
+			},
+			expectedError: ErrInvalidConflict,
+		},
+		{
+			name: "should return error when conflict action is missing",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflict("email")
+			},
+			expectedError: ErrInvalidConflict,
+		},
+		{
+			name: "should return error when DO UPDATE has no assignments",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflict("email").
+					DoUpdateWhere(func(q QueryBuilder) {
+						q.Where("active", "=", true)
 					})
 			},
-			expectedError: ErrEmptyTable,
+			expectedError: ErrInvalidConflict,
+		},
+		{
+			name: "should return error when action is declared before OnConflict",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					DoNothing()
+			},
+			expectedError: ErrInvalidConflict,
 		},
 	}
 
@@ -5210,12 +7098,12 @@ func TestPostgresDialect_OrWhereNotExists(t *testing.T) {
 			tt.build(b)
 
 			// Act
-			sql, args, err := b.dialect.CompileSelect(b)
+			sql, args, err := b.dialect.CompileInsert(b)
 
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -5228,7 +7116,7 @@ func TestPostgresDialect_OrWhereNotExists(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_OrderBy(t *testing.T) {
+func TestPostgresDialect_Union(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -5239,37 +7127,118 @@ func TestPostgresDialect_OrderBy(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build single order by clause",
+			name: "should build UNION of two queries",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("id", "name").
 					From("users").
-					OrderBy("name", "ASC")
+					Union(func(q QueryBuilder) {
+						q.Select("id", "name").From("admins")
+					})
+			},
+			expectedSQL:  `SELECT "id", "name" FROM "users" UNION (SELECT "id", "name" FROM "admins")`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should renumber placeholders across branches",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Where("status", "=", "active").
+					UnionAll(func(q QueryBuilder) {
+						q.Select("id").From("admins").Where("level", ">", 2).Where("team", "=", "ops")
+					}).
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("guests").WhereIn("country", "DE", "FR")
+					})
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE "status" = $1 UNION ALL (SELECT "id" FROM "admins" WHERE "level" > $2 AND "team" = $3) UNION (SELECT "id" FROM "guests" WHERE "country" IN ($4, $5))`,
+			expectedArgs: []any{"active", 2, "ops", "DE", "FR"},
+		},
+		{
+			name: "should renumber placeholders of nested subqueries inside a branch",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					Where("age", ">", 18).
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins").WhereSub("team_id", "IN", func(sq QueryBuilder) {
+							sq.Select("id").From("teams").Where("name", "=", "core")
+						})
+					})
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE "age" > $1 UNION (SELECT "id" FROM "admins" WHERE "team_id" IN (SELECT "id" FROM "teams" WHERE "name" = $2))`,
+			expectedArgs: []any{18, "core"},
+		},
+		{
+			name: "should apply ORDER BY and LIMIT to the combined result",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id", "name").
+					From("users").
+					Union(func(q QueryBuilder) {
+						q.Select("id", "name").From("admins")
+					}).
+					OrderBy("name", "ASC").
+					OrderByRaw("id % ?", 2).
+					Limit(10).
+					Offset(20)
+			},
+			expectedSQL:  `SELECT "id", "name" FROM "users" UNION (SELECT "id", "name" FROM "admins") ORDER BY "name" ASC, id % $1 LIMIT 10 OFFSET 20`,
+			expectedArgs: []any{2},
+		},
+		{
+			name: "should keep branch ORDER BY and LIMIT inside parentheses",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("id").
+					From("users").
+					UnionAll(func(q QueryBuilder) {
+						q.Select("id").From("admins").OrderBy("id", "DESC").Limit(5)
+					})
+			},
+			expectedSQL:  `SELECT "id" FROM "users" UNION ALL (SELECT "id" FROM "admins" ORDER BY "id" DESC LIMIT 5)`,
+			expectedArgs: []any{},
+		},
+		{
+			name: "should build INTERSECT and EXCEPT",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id").
+					From("orders").
+					Intersect(func(q QueryBuilder) {
+						q.Select("user_id").From("subscriptions").Where("active", "=", true)
+					}).
+					Except(func(q QueryBuilder) {
+						q.Select("user_id").From("bans")
+					})
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY "name" ASC`,
-			expectedArgs: []any{},
+			expectedSQL:  `SELECT "user_id" FROM "orders" INTERSECT (SELECT "user_id" FROM "subscriptions" WHERE "active" = $1) EXCEPT (SELECT "user_id" FROM "bans")`,
+			expectedArgs: []any{true},
 		},
 		{
-			name: "should build multiple order by clauses",
+			name: "should return error from branch builder",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("id").
 					From("users").
-					OrderBy("name", "ASC").
-					OrderBy("age", "DESC")
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins").WhereIn("", 1)
+					})
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY "name" ASC, "age" DESC`,
-			expectedArgs: []any{},
+			expectedError: ErrEmptyColumn,
 		},
 		{
-			name: "should return error when column name is empty",
+			name: "should return error when branch function is nil",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					Select("id").
 					From("users").
-					OrderBy("", "ASC")
+					Union(nil)
 			},
-			expectedError: ErrEmptyColumn,
+			expectedError: ErrNilFunc,
 		},
 	}
 
@@ -5291,7 +7260,7 @@ func TestPostgresDialect_OrderBy(t *testing.T) {
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -5304,7 +7273,7 @@ func TestPostgresDialect_OrderBy(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_OrderByRaw(t *testing.T) {
+func TestPostgresDialect_GroupByHaving(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -5315,37 +7284,130 @@ func TestPostgresDialect_OrderByRaw(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build single raw order by clause",
+			name: "should build GROUP BY with a single column",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					OrderByRaw("LENGTH(name) DESC")
+					Select("status").
+					AddSelectRaw("COUNT(*) AS total").
+					From("orders").
+					GroupBy("status")
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY LENGTH(name) DESC`,
+			expectedSQL:  `SELECT "status", COUNT(*) AS total FROM "orders" GROUP BY "status"`,
 			expectedArgs: []any{},
 		},
 		{
-			name: "should build multiple raw order by clauses with args",
+			name: "should build GROUP BY with qualified columns and raw expression",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
-					From("users").
-					OrderByRaw("LENGTH(name) ASC").
-					OrderByRaw("CASE WHEN status = ? THEN 1 ELSE 2 END", "active")
+					SelectRaw("date_trunc(?, o.created_at) AS period, u.country, SUM(o.amount)", "month").
+					From("orders o").
+					Join("users u", "u.id", "=", "o.user_id").
+					GroupByRaw("date_trunc(?, o.created_at)", "month").
+					GroupBy("u.country")
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY LENGTH(name) ASC, CASE WHEN status = $1 THEN 1 ELSE 2 END`,
-			expectedArgs: []any{"active"},
+			expectedSQL:  `SELECT date_trunc($1, o.created_at) AS period, u.country, SUM(o.amount) FROM "orders" AS "o" INNER JOIN "users" AS "u" ON "u"."id" = "o"."user_id" GROUP BY date_trunc($2, o.created_at), "u"."country"`,
+			expectedArgs: []any{"month", "month"},
 		},
 		{
-			name: "should return error when raw expression is empty",
+			name: "should order args as select, where, group by, having, order by",
 			build: func(b *builder) QueryBuilder {
 				return b.
-					Select().
+					SelectRaw("country, SUM(amount) * ? AS gross", 1.2).
+					From("orders").
+					Where("status", "=", "paid").
+					GroupByRaw("country").
+					HavingRaw("SUM(amount) > ?", 1000).
+					OrderByRaw("SUM(amount) * ? DESC", 2).
+					Limit(10)
+			},
+			expectedSQL:  `SELECT country, SUM(amount) * $1 AS gross FROM "orders" WHERE "status" = $2 GROUP BY country HAVING SUM(amount) > $3 ORDER BY SUM(amount) * $4 DESC LIMIT 10`,
+			expectedArgs: []any{1.2, "paid", 1000, 2},
+		},
+		{
+			name: "should build HAVING with AND, OR, BETWEEN and IN",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("country").
 					From("users").
-					OrderByRaw("")
+					GroupBy("country").
+					Having("COUNT(*)", ">", 10).
+					OrHavingBetween("AVG(age)", 20, 30).
+					HavingIn("country", "DE", "FR")
 			},
-			expectedError: ErrEmptyExpression,
+			expectedSQL:  `SELECT "country" FROM "users" GROUP BY "country" HAVING COUNT(*) > $1 OR (AVG("age") BETWEEN $2 AND $3) AND "country" IN ($4, $5)`,
+			expectedArgs: []any{10, 20, 30, "DE", "FR"},
+		},
+		{
+			name: "should build HAVING on aggregate calls in nested groups",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id").
+					From("orders").
+					GroupBy("user_id").
+					HavingGroup(func(q QueryBuilder) {
+						q.Where("count(DISTINCT orders.product_id)", ">=", 2).OrWhere("SUM(amount)", ">", Col("MAX(limit)"))
+					})
+			},
+			expectedSQL:  `SELECT "user_id" FROM "orders" GROUP BY "user_id" HAVING (count(DISTINCT "orders"."product_id") >= $1 OR SUM("amount") > MAX("limit"))`,
+			expectedArgs: []any{2},
+		},
+		{
+			name: "should keep quoting aggregate-looking columns in WHERE",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id").
+					From("orders").
+					Where("COUNT(*)", ">", 1)
+			},
+			expectedSQL:  `SELECT "user_id" FROM "orders" WHERE "COUNT(*)" > $1`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build nested HAVING groups",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id").
+					From("orders").
+					GroupBy("user_id").
+					HavingRaw("COUNT(*) > ?", 1).
+					HavingGroup(func(q QueryBuilder) {
+						q.WhereRaw("SUM(amount) > ?", 500).OrWhereRaw("MAX(amount) > ?", 200)
+					})
+			},
+			expectedSQL:  `SELECT "user_id" FROM "orders" GROUP BY "user_id" HAVING COUNT(*) > $1 AND (SUM(amount) > $2 OR MAX(amount) > $3)`,
+			expectedArgs: []any{1, 500, 200},
+		},
+		{
+			name: "should build HAVING without GROUP BY",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					SelectRaw("COUNT(*)").
+					From("orders").
+					HavingRaw("COUNT(*) > ?", 0)
+			},
+			expectedSQL:  `SELECT COUNT(*) FROM "orders" HAVING COUNT(*) > $1`,
+			expectedArgs: []any{0},
+		},
+		{
+			name: "should return error when GROUP BY column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("status").
+					From("orders").
+					GroupBy("")
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when HAVING column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("status").
+					From("orders").
+					GroupBy("status").
+					HavingIn("", 1)
+			},
+			expectedError: ErrEmptyColumn,
 		},
 	}
 
@@ -5367,7 +7429,7 @@ func TestPostgresDialect_OrderByRaw(t *testing.T) {
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -5380,7 +7442,7 @@ func TestPostgresDialect_OrderByRaw(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_OrderBySafe(t *testing.T) {
+func TestPostgresDialect_With(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -5391,71 +7453,148 @@ func TestPostgresDialect_OrderBySafe(t *testing.T) {
 		expectedError error
 	}{
 		{
-			name: "should build order by clause with valid whitelist entry",
+			name: "should build select with a CTE",
 			build: func(b *builder) QueryBuilder {
-				whitelist := map[string]string{
-					"name": "users.name",
-				}
 				return b.
-					Select().
-					From("users").
-					OrderBySafe("name", "ASC", whitelist)
+					With("active_users", func(q QueryBuilder) {
+						q.Select("id", "name").From("users").Where("active", "=", true)
+					}).
+					Select("name").
+					From("active_users")
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY "users"."name" ASC`,
-			expectedArgs: []any{},
+			expectedSQL:  `WITH "active_users" AS (SELECT "id", "name" FROM "users" WHERE "active" = $1) SELECT "name" FROM "active_users"`,
+			expectedArgs: []any{true},
 		},
 		{
-			name: "should default to ASC when direction is invalid",
+			name: "should renumber placeholders across multiple CTEs and the main query",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					With("recent", func(q QueryBuilder) {
+						q.Select("id", "user_id").From("orders").Where("created_at", ">", "2024-01-01")
+					}).
+					WithColumns("big", []string{"user_id", "total"}, func(q QueryBuilder) {
+						q.Select("user_id").AddSelectRaw("SUM(amount)").From("orders").GroupBy("user_id").HavingRaw("SUM(amount) > ?", 1000)
+					}).
+					Select("u.name").
+					From("users u").
+					WhereSub("u.id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").From("recent").Where("user_id", ">", 10)
+					}).
+					Where("u.country", "=", "DE")
+			},
+			expectedSQL:  `WITH "recent" AS (SELECT "id", "user_id" FROM "orders" WHERE "created_at" > $1), "big" ("user_id", "total") AS (SELECT "user_id", SUM(amount) FROM "orders" GROUP BY "user_id" HAVING SUM(amount) > $2) SELECT "u"."name" FROM "users" AS "u" WHERE "u"."id" IN (SELECT "user_id" FROM "recent" WHERE "user_id" > $3) AND "u"."country" = $4`,
+			expectedArgs: []any{"2024-01-01", 1000, 10, "DE"},
+		},
+		{
+			name: "should build recursive CTE for tree traversal",
 			build: func(b *builder) QueryBuilder {
-				whitelist := map[string]string{
-					"age": "users.age",
-				}
 				return b.
+					WithRecursive("tree", []string{"id", "parent_id", "depth"}, func(q QueryBuilder) {
+						q.Select("id", "parent_id").
+							AddSelectRaw("0").
+							From("categories").
+							Where("id", "=", 1).
+							UnionAll(func(r QueryBuilder) {
+								r.Select("c.id", "c.parent_id").
+									AddSelectRaw("t.depth + 1").
+									From("categories c").
+									Join("tree t", "t.id", "=", "c.parent_id").
+									Where("t.depth", "<", 5)
+							})
+					}).
 					Select().
-					From("users").
-					OrderBySafe("age", "INVALID", whitelist)
+					From("tree")
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY "users"."age" ASC`,
-			expectedArgs: []any{},
+			expectedSQL:  `WITH RECURSIVE "tree" ("id", "parent_id", "depth") AS (SELECT "id", "parent_id", 0 FROM "categories" WHERE "id" = $1 UNION ALL (SELECT "c"."id", "c"."parent_id", t.depth + 1 FROM "categories" AS "c" INNER JOIN "tree" AS "t" ON "t"."id" = "c"."parent_id" WHERE "t"."depth" < $2)) SELECT * FROM "tree"`,
+			expectedArgs: []any{1, 5},
 		},
 		{
-			name: "should ignore when user input not in whitelist",
+			name: "should emit RECURSIVE once when mixed with plain CTEs",
 			build: func(b *builder) QueryBuilder {
-				whitelist := map[string]string{
-					"name": "users.name",
-				}
 				return b.
+					With("roots", func(q QueryBuilder) {
+						q.Select("id").From("employees").WhereNull("manager_id")
+					}).
+					WithRecursive("chart", nil, func(q QueryBuilder) {
+						q.Select("id").From("roots")
+					}).
 					Select().
-					From("users").
-					OrderBySafe("email", "DESC", whitelist)
+					From("chart")
 			},
-			expectedSQL:  `SELECT * FROM "users"`,
+			expectedSQL:  `WITH RECURSIVE "roots" AS (SELECT "id" FROM "employees" WHERE "manager_id" IS NULL), "chart" AS (SELECT "id" FROM "roots") SELECT * FROM "chart"`,
 			expectedArgs: []any{},
 		},
 		{
-			name: "should allow DESC when provided correctly",
+			name: "should build MATERIALIZED and NOT MATERIALIZED hints",
 			build: func(b *builder) QueryBuilder {
-				whitelist := map[string]string{
-					"created_at": "users.created_at",
-				}
 				return b.
+					WithMaterialized("a", func(q QueryBuilder) {
+						q.Select("id").From("x")
+					}).
+					WithNotMaterialized("b", func(q QueryBuilder) {
+						q.Select("id").From("y")
+					}).
 					Select().
-					From("users").
-					OrderBySafe("created_at", "DESC", whitelist)
+					From("a")
 			},
-			expectedSQL:  `SELECT * FROM "users" ORDER BY "users"."created_at" DESC`,
+			expectedSQL:  `WITH "a" AS MATERIALIZED (SELECT "id" FROM "x"), "b" AS NOT MATERIALIZED (SELECT "id" FROM "y") SELECT * FROM "a"`,
 			expectedArgs: []any{},
 		},
 		{
-			name: "should return error when mapped column is empty",
+			name: "should build CTE ahead of INSERT",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					With("src", func(q QueryBuilder) {
+						q.Select("id").From("staging").Where("batch", "=", 7)
+					}).
+					Insert("archive").
+					Columns("note").
+					Values("imported")
+			},
+			expectedSQL:  `WITH "src" AS (SELECT "id" FROM "staging" WHERE "batch" = $1) INSERT INTO "archive" ("note") VALUES ($2)`,
+			expectedArgs: []any{7, "imported"},
+		},
+		{
+			name: "should build CTE ahead of UPDATE",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					With("stale", func(q QueryBuilder) {
+						q.Select("id").From("users").Where("last_login", "<", "2023-01-01")
+					}).
+					Update("users").
+					Set("active", false).
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("id").From("stale")
+					})
+			},
+			expectedSQL:  `WITH "stale" AS (SELECT "id" FROM "users" WHERE "last_login" < $1) UPDATE "users" SET "active" = $2 WHERE "id" IN (SELECT "id" FROM "stale")`,
+			expectedArgs: []any{"2023-01-01", false},
+		},
+		{
+			name: "should build CTE ahead of DELETE",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					With("expired", func(q QueryBuilder) {
+						q.Select("id").From("sessions").Where("expires_at", "<", "2024-01-01")
+					}).
+					DeleteFrom("sessions").
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("id").From("expired")
+					}).
+					Returning("id")
+			},
+			expectedSQL:  `WITH "expired" AS (SELECT "id" FROM "sessions" WHERE "expires_at" < $1) DELETE FROM "sessions" WHERE "id" IN (SELECT "id" FROM "expired") RETURNING "id"`,
+			expectedArgs: []any{"2024-01-01"},
+		},
+		{
+			name: "should return error from CTE body",
 			build: func(b *builder) QueryBuilder {
-				whitelist := map[string]string{
-					"age": "",
-				}
 				return b.
+					With("broken", func(q QueryBuilder) {
+						q.Select("id").From("users").WhereIn("", 1)
+					}).
 					Select().
-					From("users").
-					OrderBySafe("age", "ASC", whitelist)
+					From("broken")
 			},
 			expectedError: ErrEmptyColumn,
 		},
@@ -5474,12 +7613,12 @@ func TestPostgresDialect_OrderBySafe(t *testing.T) {
 			tt.build(b)
 
 			// Act
-			sql, args, err := b.dialect.CompileSelect(b)
+			sql, args, err := b.ToSQL()
 
 			// Assert
 			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.ErrorIs(t, err, tt.expectedError, "expected error to match output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -5492,58 +7631,110 @@ func TestPostgresDialect_OrderBySafe(t *testing.T) {
 	}
 }
 
-func TestPostgresDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+func TestPostgresDialect_Distinct(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name          string
-		table         string
-		limit         int
-		offset        int
+		build         func(*builder) QueryBuilder
 		expectedSQL   string
 		expectedArgs  []any
-		expectedError string
+		expectedError error
 	}{
 		{
-			name:         "should build select with limit",
-			table:        "users",
-			limit:        10,
-			offset:       -1, // default
-			expectedSQL:  `SELECT * FROM "users" LIMIT 10`,
+			name: "should build SELECT DISTINCT with quoted columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("u.country", "u.city AS town").
+					Distinct().
+					From("users u")
+			},
+			expectedSQL:  `SELECT DISTINCT "u"."country", "u"."city" AS "town" FROM "users" AS "u"`,
 			expectedArgs: []any{},
 		},
 		{
-			name:         "should build select with offset",
-			table:        "users",
-			limit:        -1, // default
-			offset:       5,
-			expectedSQL:  `SELECT * FROM "users" OFFSET 5`,
+			name: "should build SELECT DISTINCT *",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Distinct().
+					Select().
+					From("tags")
+			},
+			expectedSQL:  `SELECT DISTINCT * FROM "tags"`,
 			expectedArgs: []any{},
 		},
 		{
-			name:         "should build select with limit and offset",
-			table:        "users",
-			limit:        10,
-			offset:       5,
-			expectedSQL:  `SELECT * FROM "users" LIMIT 10 OFFSET 5`,
+			name: "should build DISTINCT ON without ORDER BY",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("user_id", "amount").
+					DistinctOn("user_id").
+					From("orders")
+			},
+			expectedSQL:  `SELECT DISTINCT ON ("user_id") "user_id", "amount" FROM "orders"`,
 			expectedArgs: []any{},
 		},
 		{
-			name:         "should ignore negative limit and offset",
-			table:        "users",
-			limit:        -10,
-			offset:       -5,
-			expectedSQL:  `SELECT * FROM "users"`,
-			expectedArgs: []any{},
+			name: "should build DISTINCT ON with matching leading ORDER BY",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select("o.user_id", "o.amount").
+					DistinctOn("o.user_id").
+					From("orders o").
+					Where("o.status", "=", "paid").
+					OrderBy("o.user_id", "ASC").
+					OrderBy("o.created_at", "DESC")
+			},
+			expectedSQL:  `SELECT DISTINCT ON ("o"."user_id") "o"."user_id", "o"."amount" FROM "orders" AS "o" WHERE "o"."status" = $1 ORDER BY "o"."user_id" ASC, "o"."created_at" DESC`,
+			expectedArgs: []any{"paid"},
 		},
 		{
-			name:         "should handle zero limit and offset",
-			table:        "users",
-			limit:        0,
-			offset:       0,
-			expectedSQL:  `SELECT * FROM "users" LIMIT 0 OFFSET 0`,
+			name: "should accept DISTINCT ON columns in a different order than ORDER BY",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					DistinctOn("a", "b").
+					From("t").
+					OrderBy("b", "ASC").
+					OrderBy("a", "ASC").
+					OrderBy("c", "DESC")
+			},
+			expectedSQL:  `SELECT DISTINCT ON ("a", "b") * FROM "t" ORDER BY "b" ASC, "a" ASC, "c" DESC`,
 			expectedArgs: []any{},
 		},
+		{
+			name: "should return error when ORDER BY does not start with DISTINCT ON columns",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					DistinctOn("user_id").
+					From("orders").
+					OrderBy("created_at", "DESC")
+			},
+			expectedError: ErrInvalidDistinctOn,
+		},
+		{
+			name: "should return error when ORDER BY is shorter than DISTINCT ON",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					DistinctOn("a", "b").
+					From("t").
+					OrderBy("a", "ASC")
+			},
+			expectedError: ErrInvalidDistinctOn,
+		},
+		{
+			name: "should return error when leading ORDER BY is raw",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					Select().
+					DistinctOn("user_id").
+					From("orders").
+					OrderByRaw("user_id DESC")
+			},
+			expectedError: ErrInvalidDistinctOn,
+		},
 	}
 
 	for _, tt := range tests {
@@ -5553,22 +7744,18 @@ func TestPostgresDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
 			// Arrange
 			b := &builder{
 				dialect: PostgresDialect{},
-				action:  "select",
-				table: table{
-					queryType: QueryBasic,
-					name:      tt.table,
-				},
-				limit:  tt.limit,
-				offset: tt.offset,
+				limit:   -1,
+				offset:  -1,
 			}
+			tt.build(b)
 
 			// Act
 			sql, args, err := b.dialect.CompileSelect(b)
 
 			// Assert
-			if tt.expectedError != "" {
+			if tt.expectedError != nil {
 				assert.Error(t, err, "expected an error")
-				assert.Contains(t, err.Error(), tt.expectedError, "expected error message to contain output")
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
 				assert.Empty(t, sql, "expected empty SQL on error")
 				assert.Empty(t, args, "expected empty args on error")
 				return
@@ -6947,3 +9134,37 @@ func BenchmarkPostgresDialect_OrderBySafe(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkPostgresDialect_Insert(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: PostgresDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Insert("users").
+			Columns("name", "email").
+			Values("John", "john@example.com").
+			Values("Jane", "jane@example.com")
+
+		_, _, _ = bd.dialect.CompileInsert(bd)
+	}
+}
+
+func BenchmarkPostgresDialect_Update(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: PostgresDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Update("users").
+			Set("name", "John").
+			Set("email", "john@example.com").
+			Where("id", "=", 1)
+
+		_, _, _ = bd.dialect.CompileUpdate(bd)
+	}
+}
@@ -0,0 +1,71 @@
+package sequel
+
+func (b *builder) PreWhere(column string, operator string, values ...any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhere("AND", column, operator, values...) })
+	return b
+}
+
+func (b *builder) OrPreWhere(column string, operator string, values ...any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhere("OR", column, operator, values...) })
+	return b
+}
+
+func (b *builder) PreWhereBetween(column string, from, to any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereBetween("AND", column, "BETWEEN", from, to) })
+	return b
+}
+
+func (b *builder) OrPreWhereBetween(column string, from, to any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereBetween("OR", column, "BETWEEN", from, to) })
+	return b
+}
+
+func (b *builder) PreWhereIn(column string, values ...any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereIn("AND", column, "IN", values...) })
+	return b
+}
+
+func (b *builder) OrPreWhereIn(column string, values ...any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereIn("OR", column, "IN", values...) })
+	return b
+}
+
+func (b *builder) PreWhereRaw(expr string, args ...any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereRaw("AND", expr, args...) })
+	return b
+}
+
+func (b *builder) OrPreWhereRaw(expr string, args ...any) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereRaw("OR", expr, args...) })
+	return b
+}
+
+func (b *builder) PreWhereGroup(fn func(QueryBuilder)) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereGroup("AND", fn) })
+	return b
+}
+
+func (b *builder) OrPreWhereGroup(fn func(QueryBuilder)) QueryBuilder {
+	b.addPreWhere(func(pb *builder) { pb.addWhereGroup("OR", fn) })
+	return b
+}
+
+// addPreWhere builds the condition with the WHERE helpers on a scratch builder
+// so PREWHERE shares their validation and condition tree.
+func (b *builder) addPreWhere(fn func(*builder)) {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsPreWhere {
+		b.addErr(unsupportedFeature("PREWHERE"))
+		return
+	}
+
+	preWhereBuilder := New(b.dialect).(*builder)
+	fn(preWhereBuilder)
+
+	// propagate child error
+	if preWhereBuilder.err != nil {
+		b.addErr(preWhereBuilder.err)
+		return
+	}
+
+	b.preWheres = append(b.preWheres, preWhereBuilder.wheres...)
+}
@@ -0,0 +1,170 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_PreWhere(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		dialect           Dialect
+		initialPreWheres  []where
+		build             func(*builder) QueryBuilder
+		expectedPreWheres []where
+		expectedError     error
+	}{
+		{
+			name: "should add a basic PREWHERE condition",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhere("event_date", ">=", "2024-01-01")
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryBasic, conj: "AND", column: "event_date", operator: ">=", args: []any{"2024-01-01"}},
+			},
+		},
+		{
+			name: "should add an OR PREWHERE condition after existing ones",
+			initialPreWheres: []where{
+				{queryType: QueryBasic, conj: "AND", column: "site_id", operator: "=", args: []any{1}},
+			},
+			build: func(b *builder) QueryBuilder {
+				return b.OrPreWhere("site_id", "=", 2)
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryBasic, conj: "AND", column: "site_id", operator: "=", args: []any{1}},
+				{queryType: QueryBasic, conj: "OR", column: "site_id", operator: "=", args: []any{2}},
+			},
+		},
+		{
+			name: "should route operators like Where does",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhere("status", "IN", "a", "b")
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryIn, conj: "AND", column: "status", operator: "IN", args: []any{"a", "b"}},
+			},
+		},
+		{
+			name: "should add PREWHERE BETWEEN and OR PREWHERE BETWEEN",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereBetween("event_date", "2024-01-01", "2024-01-31").OrPreWhereBetween("duration", 1, 2)
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryBetween, conj: "AND", column: "event_date", operator: "BETWEEN", args: []any{"2024-01-01", "2024-01-31"}},
+				{queryType: QueryBetween, conj: "OR", column: "duration", operator: "BETWEEN", args: []any{1, 2}},
+			},
+		},
+		{
+			name: "should add PREWHERE IN and OR PREWHERE IN with flattened values",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereIn("country", []string{"DE", "FR"}).OrPreWhereIn("city", "Rome")
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryIn, conj: "AND", column: "country", operator: "IN", args: []any{"DE", "FR"}},
+				{queryType: QueryIn, conj: "OR", column: "city", operator: "IN", args: []any{"Rome"}},
+			},
+		},
+		{
+			name: "should add PREWHERE RAW and OR PREWHERE RAW",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereRaw("toDate(ts) = ?", "2024-01-01").OrPreWhereRaw("is_bot = 0")
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryRaw, conj: "AND", expr: "toDate(ts) = ?", args: []any{"2024-01-01"}},
+				{queryType: QueryRaw, conj: "OR", expr: "is_bot = 0"},
+			},
+		},
+		{
+			name: "should add PREWHERE groups",
+			build: func(b *builder) QueryBuilder {
+				return b.
+					PreWhereGroup(func(q QueryBuilder) {
+						q.Where("site_id", "=", 1).OrWhere("site_id", "=", 2)
+					}).
+					OrPreWhereGroup(func(q QueryBuilder) {
+						q.WhereRaw("has(tags, ?)", "beta")
+					})
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryNested, conj: "AND", nested: []where{
+					{queryType: QueryBasic, conj: "AND", column: "site_id", operator: "=", args: []any{1}},
+					{queryType: QueryBasic, conj: "OR", column: "site_id", operator: "=", args: []any{2}},
+				}},
+				{queryType: QueryNested, conj: "OR", nested: []where{
+					{queryType: QueryRaw, conj: "AND", expr: "has(tags, ?)", args: []any{"beta"}},
+				}},
+			},
+		},
+		{
+			name: "should not touch WHERE conditions",
+			build: func(b *builder) QueryBuilder {
+				return b.Where("active", "=", true).PreWhere("site_id", "=", 1)
+			},
+			expectedPreWheres: []where{
+				{queryType: QueryBasic, conj: "AND", column: "site_id", operator: "=", args: []any{1}},
+			},
+		},
+		{
+			name: "should return error when PREWHERE BETWEEN column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereBetween("", 1, 2)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when PREWHERE IN column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereIn("", 1)
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when PREWHERE RAW expression is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereRaw("")
+			},
+			expectedError: ErrEmptyExpression,
+		},
+		{
+			name: "should return error when PREWHERE group function is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhereGroup(nil)
+			},
+			expectedError: ErrNilFunc,
+		},
+		{
+			name:    "should return error when dialect does not support PREWHERE",
+			dialect: limitedDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.PreWhere("site_id", "=", 1)
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect, preWheres: tt.initialPreWheres}
+
+			// Act
+			result := tt.build(b)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedPreWheres, b.preWheres, "expected PREWHERE to be updated correctly")
+			assert.Equal(t, b, result, "expected method to return the same builder instance")
+		})
+	}
+}
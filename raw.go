@@ -0,0 +1,162 @@
+package sequel
+
+import "strings"
+
+// countRawMarkers returns how many "?" markers rewriteRaw binds in expr.
+func countRawMarkers(expr string, backslash bool) int {
+	_, n := rewriteRaw(expr, backslash, func() string { return "?" }, nil)
+	return n
+}
+
+// rewriteRaw copies expr, replacing every "?" marker with the result of next
+// and, when named is not nil, every :name marker with named(name).
+// Quoted strings, quoted identifiers, dollar-quoted bodies and comments are
+// copied untouched, "??" is written as a literal "?", the Postgres JSONB
+// operators ?| and ?& and "::" casts are kept. When backslash is set, a
+// backslash escapes the next character of a quoted string, as in MySQL. It
+// also returns the number of "?" markers.
+func rewriteRaw(expr string, backslash bool, next func() string, named func(name string) string) (string, int) {
+	var sb strings.Builder
+	sb.Grow(len(expr))
+	n := 0
+
+	for i := 0; i < len(expr); {
+		ch := expr[i]
+
+		switch {
+		case ch == '\'' || ch == '"' || ch == '`':
+			end := skipQuoted(expr, i, ch, backslash && ch != '`')
+			sb.WriteString(expr[i:end])
+			i = end
+
+		case ch == '-' && strings.HasPrefix(expr[i:], "--"):
+			end := strings.IndexByte(expr[i:], '\n')
+			if end < 0 {
+				end = len(expr)
+			} else {
+				end += i + 1
+			}
+			sb.WriteString(expr[i:end])
+			i = end
+
+		case ch == '/' && strings.HasPrefix(expr[i:], "/*"):
+			end := strings.Index(expr[i+2:], "*/")
+			if end < 0 {
+				end = len(expr)
+			} else {
+				end += i + 4
+			}
+			sb.WriteString(expr[i:end])
+			i = end
+
+		case ch == '$':
+			end := skipDollarQuoted(expr, i)
+			sb.WriteString(expr[i:end])
+			i = end
+
+		case ch == ':':
+			if i+1 < len(expr) && expr[i+1] == ':' { // type cast
+				sb.WriteString("::")
+				i += 2
+				continue
+			}
+
+			end := i + 1
+			for end < len(expr) && isNameChar(expr[end], end == i+1) {
+				end++
+			}
+
+			if named == nil || end == i+1 {
+				sb.WriteByte(ch)
+				i++
+				continue
+			}
+
+			sb.WriteString(named(expr[i+1 : end]))
+			i = end
+
+		case ch == '?':
+			if i+1 < len(expr) {
+				switch expr[i+1] {
+				case '?': // escaped literal question mark
+					sb.WriteByte('?')
+					i += 2
+					continue
+
+				case '|', '&': // JSONB ?| and ?& operators
+					sb.WriteString(expr[i : i+2])
+					i += 2
+					continue
+				}
+			}
+
+			sb.WriteString(next())
+			n++
+			i++
+
+		default:
+			sb.WriteByte(ch)
+			i++
+		}
+	}
+
+	return sb.String(), n
+}
+
+// skipQuoted returns the index just past the quoted section starting at
+// start. A doubled quote inside the section is an escaped quote, and so is
+// any character after a backslash when backslash is set.
+func skipQuoted(expr string, start int, quote byte, backslash bool) int {
+	for i := start + 1; i < len(expr); i++ {
+		if backslash && expr[i] == '\\' {
+			i++
+			continue
+		}
+
+		if expr[i] != quote {
+			continue
+		}
+
+		if i+1 < len(expr) && expr[i+1] == quote {
+			i++
+			continue
+		}
+
+		return i + 1
+	}
+
+	return len(expr)
+}
+
+// skipDollarQuoted returns the index just past the Postgres dollar-quoted
+// body ($$...$$ or $tag$...$tag$) starting at start, or start+1 when the
+// "$" does not open one (e.g. a $1 placeholder).
+func skipDollarQuoted(expr string, start int) int {
+	i := start + 1
+	for i < len(expr) && isNameChar(expr[i], i == start+1) {
+		i++
+	}
+
+	if i >= len(expr) || expr[i] != '$' {
+		return start + 1
+	}
+
+	tag := expr[start : i+1]
+	end := strings.Index(expr[i+1:], tag)
+	if end < 0 {
+		return len(expr)
+	}
+
+	return i + 1 + end + len(tag)
+}
+
+func isNameChar(ch byte, first bool) bool {
+	switch {
+	case ch == '_', ch >= 'a' && ch <= 'z', ch >= 'A' && ch <= 'Z':
+		return true
+	case ch >= '0' && ch <= '9':
+		return !first
+	default:
+		return false
+	}
+}
@@ -0,0 +1,190 @@
+package sequel
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          string
+		backslash     bool
+		expectedSQL   string
+		expectedCount int
+	}{
+		{
+			name:          "should replace every marker in order",
+			expr:          "a = ? AND b IN (?, ?)",
+			expectedSQL:   "a = $1 AND b IN ($2, $3)",
+			expectedCount: 3,
+		},
+		{
+			name:          "should leave expressions without markers untouched",
+			expr:          "a = b",
+			expectedSQL:   "a = b",
+			expectedCount: 0,
+		},
+		{
+			name:          "should skip single quoted strings with escaped quotes",
+			expr:          "a = 'it''s ?' AND b = ?",
+			expectedSQL:   "a = 'it''s ?' AND b = $1",
+			expectedCount: 1,
+		},
+		{
+			name:          "should skip double quoted and backtick identifiers",
+			expr:          "\"a?\" = ? AND `b?` = ?",
+			expectedSQL:   "\"a?\" = $1 AND `b?` = $2",
+			expectedCount: 2,
+		},
+		{
+			name:          "should skip line comments up to the newline",
+			expr:          "a = ? -- b = ?\nAND c = ?",
+			expectedSQL:   "a = $1 -- b = ?\nAND c = $2",
+			expectedCount: 2,
+		},
+		{
+			name:          "should skip block comments",
+			expr:          "a = ? /* b = ? */ AND c = ?",
+			expectedSQL:   "a = $1 /* b = ? */ AND c = $2",
+			expectedCount: 2,
+		},
+		{
+			name:          "should skip dollar-quoted bodies with and without a tag",
+			expr:          "$$ ? $$ || $fn$ ? $ ? $fn$ || ?",
+			expectedSQL:   "$$ ? $$ || $fn$ ? $ ? $fn$ || $1",
+			expectedCount: 1,
+		},
+		{
+			name:          "should not treat numbered placeholders as dollar quotes",
+			expr:          "a = $1 AND b = ?",
+			expectedSQL:   "a = $1 AND b = $1",
+			expectedCount: 1,
+		},
+		{
+			name:          "should unescape ?? to a literal question mark",
+			expr:          "data ?? 'key' AND id = ?",
+			expectedSQL:   "data ? 'key' AND id = $1",
+			expectedCount: 1,
+		},
+		{
+			name:          "should keep the ?| and ?& operators",
+			expr:          "tags ?| ? AND tags ?& ?",
+			expectedSQL:   "tags ?| $1 AND tags ?& $2",
+			expectedCount: 2,
+		},
+		{
+			name:          "should leave :name markers and casts alone without a named binder",
+			expr:          "a::text = :name AND b = ?",
+			expectedSQL:   "a::text = :name AND b = $1",
+			expectedCount: 1,
+		},
+		{
+			name:          "should copy an unterminated string to the end",
+			expr:          "a = ? AND b = 'open ?",
+			expectedSQL:   "a = $1 AND b = 'open ?",
+			expectedCount: 1,
+		},
+		{
+			name:          "should end a string at a quote after a backslash by default",
+			expr:          `a = 'C:\' AND b = ?`,
+			expectedSQL:   `a = 'C:\' AND b = $1`,
+			expectedCount: 1,
+		},
+		{
+			name:          "should skip a backslash escaped quote for mysql",
+			expr:          `note = 'it\'s ?' AND id = ?`,
+			backslash:     true,
+			expectedSQL:   `note = 'it\'s ?' AND id = $1`,
+			expectedCount: 1,
+		},
+		{
+			name:          "should skip an escaped backslash before the closing quote for mysql",
+			expr:          `path = 'C:\\' AND id = ? AND note = "say \"?\""`,
+			backslash:     true,
+			expectedSQL:   `path = 'C:\\' AND id = $1 AND note = "say \"?\""`,
+			expectedCount: 1,
+		},
+		{
+			name:          "should not escape backtick identifiers for mysql",
+			expr:          "`a\\` = ? AND b = ?",
+			backslash:     true,
+			expectedSQL:   "`a\\` = $1 AND b = $2",
+			expectedCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			n := 0
+			next := func() string {
+				n++
+				return "$" + strconv.Itoa(n)
+			}
+
+			// Act
+			sql, count := rewriteRaw(tt.expr, tt.backslash, next, nil)
+
+			// Assert
+			assert.Equal(t, tt.expectedSQL, sql, "expected rewritten SQL to match")
+			assert.Equal(t, tt.expectedCount, count, "expected marker count to match")
+			assert.Equal(t, tt.expectedCount, countRawMarkers(tt.expr, tt.backslash), "expected countRawMarkers to match")
+		})
+	}
+}
+
+func TestRewriteRaw_Named(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		expr        string
+		expectedSQL string
+	}{
+		{
+			name:        "should replace :name markers",
+			expr:        "created_at > :since AND owner = :owner",
+			expectedSQL: "created_at > <since> AND owner = <owner>",
+		},
+		{
+			name:        "should keep casts, quoted names and numbered binds",
+			expr:        "a::date = :day AND b = ':day' AND c = :1",
+			expectedSQL: "a::date = <day> AND b = ':day' AND c = :1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			named := func(name string) string { return "<" + name + ">" }
+
+			// Act
+			sql, _ := rewriteRaw(tt.expr, false, func() string { return "?" }, named)
+
+			// Assert
+			assert.Equal(t, tt.expectedSQL, sql, "expected rewritten SQL to match")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkRewriteRaw(b *testing.B) {
+	expr := "a = ? AND note <> 'why?' AND tags ?| ? /* ? */ AND b IN (?, ?)"
+	next := func() string { return "$1" }
+
+	for b.Loop() {
+		rewriteRaw(expr, false, next, nil)
+	}
+}
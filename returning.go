@@ -0,0 +1,63 @@
+package sequel
+
+func (b *builder) Returning(columns ...string) QueryBuilder {
+	if !b.supportsReturning() {
+		return b
+	}
+
+	// Returning() without columns returns every column, just like Select()
+	if len(columns) == 0 {
+		columns = []string{"*"}
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	for _, col := range columns {
+		b.returning = append(b.returning, column{queryType: QueryBasic, name: col})
+	}
+
+	return b
+}
+
+func (b *builder) ReturningRaw(expr string, args ...any) QueryBuilder {
+	if !b.supportsReturning() {
+		return b
+	}
+
+	if expr == "" {
+		b.addErr(ErrEmptyExpression)
+		return b
+	}
+
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
+	b.returning = append(b.returning, column{
+		queryType: QueryRaw,
+		expr:      expr,
+		args:      args,
+	})
+
+	return b
+}
+
+func (b *builder) supportsReturning() bool {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsReturning {
+		b.addErr(unsupportedFeature("RETURNING"))
+		return false
+	}
+
+	return true
+}
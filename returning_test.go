@@ -0,0 +1,159 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// limitedDialect compiles like Postgres but reports no optional capabilities.
+type limitedDialect struct {
+	PostgresDialect
+}
+
+func (limitedDialect) Capabilities() DialectCapabilities {
+	return DialectCapabilities{}
+}
+
+func TestBuilder_Returning(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		dialect           Dialect
+		initialReturning  []column
+		columns           []string
+		expectedReturning []column
+		expectedError     error
+	}{
+		{
+			name:    "should add returning columns",
+			columns: []string{"id", "created_at"},
+			expectedReturning: []column{
+				{queryType: QueryBasic, name: "id"},
+				{queryType: QueryBasic, name: "created_at"},
+			},
+		},
+		{
+			name: "should append to existing returning columns",
+			initialReturning: []column{
+				{queryType: QueryBasic, name: "id"},
+			},
+			columns: []string{"name"},
+			expectedReturning: []column{
+				{queryType: QueryBasic, name: "id"},
+				{queryType: QueryBasic, name: "name"},
+			},
+		},
+		{
+			name:    "should return all columns when no columns are given",
+			columns: []string{},
+			expectedReturning: []column{
+				{queryType: QueryBasic, name: "*"},
+			},
+		},
+		{
+			name:    "should add returning columns when dialect supports it",
+			dialect: PostgresDialect{},
+			columns: []string{"id"},
+			expectedReturning: []column{
+				{queryType: QueryBasic, name: "id"},
+			},
+		},
+		{
+			name:          "should return error when a column is empty",
+			columns:       []string{"id", ""},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when dialect does not support RETURNING",
+			dialect:       limitedDialect{},
+			columns:       []string{"id"},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect, returning: tt.initialReturning}
+
+			// Act
+			result := b.Returning(tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedReturning, b.returning, "expected returning columns to be set correctly")
+			assert.Equal(t, b, result, "expected Returning() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_ReturningRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		dialect           Dialect
+		expr              string
+		args              []any
+		expectedReturning []column
+		expectedError     error
+	}{
+		{
+			name: "should add raw returning expression",
+			expr: "id, xmax = 0 AS inserted",
+			expectedReturning: []column{
+				{queryType: QueryRaw, expr: "id, xmax = 0 AS inserted"},
+			},
+		},
+		{
+			name: "should add raw returning expression with args",
+			expr: "price * ? AS gross",
+			args: []any{1.2},
+			expectedReturning: []column{
+				{queryType: QueryRaw, expr: "price * ? AS gross", args: []any{1.2}},
+			},
+		},
+		{
+			name:          "should return error when expression is empty",
+			expr:          "",
+			expectedError: ErrEmptyExpression,
+		},
+		{
+			name:          "should return error when dialect does not support RETURNING",
+			dialect:       limitedDialect{},
+			expr:          "id",
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.ReturningRaw(tt.expr, tt.args...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedReturning, b.returning, "expected returning columns to be set correctly")
+			assert.Equal(t, b, result, "expected ReturningRaw() to return the same builder instance")
+		})
+	}
+}
@@ -2,6 +2,14 @@ package sequel
 
 func (b *builder) Select(columns ...string) QueryBuilder {
 	b.action = "select"
+
+	for _, col := range columns {
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
 	if len(columns) == 0 {
 		b.columns = b.columns[:0] // reuse slice
 	}
@@ -22,6 +30,11 @@ func (b *builder) SelectRaw(expr string, args ...any) QueryBuilder {
 		return b
 	}
 
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
 	// Reset columns
 	b.columns = []column{{
 		queryType: QueryRaw,
@@ -59,6 +72,11 @@ func (b *builder) SelectSub(fn func(QueryBuilder), alias string) QueryBuilder {
 		return b
 	}
 
+	if !b.validAlias(alias) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
 	subBuilder := New(b.dialect).(*builder)
 	subBuilder.action = "select"
 	fn(subBuilder)
@@ -84,6 +102,13 @@ func (b *builder) AddSelect(columns ...string) QueryBuilder {
 		return b
 	}
 
+	for _, col := range columns {
+		if !b.validColumn(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
 	existing := make(map[string]struct{}, len(b.columns))
 	for _, col := range b.columns {
 		if col.queryType == QueryBasic {
@@ -107,6 +132,11 @@ func (b *builder) AddSelectRaw(expr string, args ...any) QueryBuilder {
 		return b
 	}
 
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
 	b.columns = append(b.columns, column{
 		queryType: QueryRaw,
 		expr:      expr,
@@ -154,6 +184,11 @@ func (b *builder) AddSelectSub(fn func(QueryBuilder), alias string) QueryBuilder
 		return b
 	}
 
+	if !b.validAlias(alias) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
 	subBuilder := New(b.dialect).(*builder)
 	subBuilder.action = "select"
 	fn(subBuilder)
@@ -121,6 +121,14 @@ func TestBuilder_SelectRaw(t *testing.T) {
 			expectedColumns: []column{},
 			expectedErr:     ErrEmptyExpression,
 		},
+		{
+			name:            "should return error when args do not match placeholders",
+			expression:      "price * ?",
+			args:            []any{},
+			expectedAction:  "select",
+			expectedColumns: []column{},
+			expectedErr:     ErrArgCountMismatch,
+		},
 	}
 
 	for _, tt := range tests {
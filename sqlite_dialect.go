@@ -0,0 +1,141 @@
+package sequel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var sqliteOperators = comparisonOperators.with(
+	"==", "IS", "IS NOT", "IS DISTINCT FROM", "IS NOT DISTINCT FROM",
+	"GLOB", "NOT GLOB", "REGEXP", "NOT REGEXP", "MATCH",
+)
+
+// SQLiteDialect targets SQLite. Version is the SQLITE_VERSION_NUMBER of the
+// linked library (3035000 for 3.35.0) and gates version specific features;
+// zero means the latest release. NumberedPlaceholders emits ?NNN instead of ?.
+// IdentifierPattern, when set, must match every part of an identifier.
+type SQLiteDialect struct {
+	Version              int
+	NumberedPlaceholders bool
+	IdentifierPattern    *regexp.Regexp
+}
+
+func (d SQLiteDialect) Capabilities() DialectCapabilities {
+	return DialectCapabilities{
+		SupportsArrayJoin:       false,
+		SupportsDistinctOn:      false,
+		SupportsExcept:          true,
+		SupportsFinal:           false,
+		SupportsFullJoin:        d.atLeast(3039000),
+		SupportsIntersect:       true,
+		SupportsJoinUsing:       true,
+		SupportsLateral:         false,
+		SupportsLimitBy:         false,
+		SupportsMaterializedCTE: d.atLeast(3035000),
+		SupportsNaturalJoin:     true,
+		SupportsPreWhere:        false,
+		SupportsReturning:       d.atLeast(3035000),
+		SupportsRightJoin:       d.atLeast(3039000),
+		SupportsSample:          false,
+		SupportsUpsert:          d.atLeast(3024000),
+	}
+}
+
+func (d SQLiteDialect) Placeholder(n int) string {
+	if d.NumberedPlaceholders {
+		return fmt.Sprintf("?%d", n)
+	}
+
+	return "?"
+}
+
+func (d SQLiteDialect) SupportsOperator(operator string) bool {
+	return sqliteOperators.has(operator)
+}
+
+func (d SQLiteDialect) ValidIdentifier(id string) bool {
+	return validIdentifier(id, d.IdentifierPattern)
+}
+
+func (d SQLiteDialect) WrapColumn(expr string) string {
+	return doubleQuotes.column(expr)
+}
+
+func (d SQLiteDialect) WrapIdentifier(id string) string {
+	return doubleQuotes.identifier(id)
+}
+
+func (d SQLiteDialect) WrapTable(expr string) string {
+	return doubleQuotes.table(expr)
+}
+
+func (d SQLiteDialect) atLeast(version int) bool {
+	return d.Version == 0 || d.Version >= version
+}
+
+func (d SQLiteDialect) compiler() compiler {
+	return compiler{
+		dialect:           d,
+		bareCompounds:     true,
+		reusePlaceholders: d.NumberedPlaceholders,
+		paging:            d.compilePaging,
+		conflict:          d.compileConflictClause,
+		updateFrom:        d.compileUpdateFrom,
+		deleteFrom:        compiler.rejectDeleteUsing,
+	}
+}
+
+func (d SQLiteDialect) CompileSelect(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileSelect, b)
+}
+
+func (d SQLiteDialect) CompileInsert(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileInsert, b)
+}
+
+func (d SQLiteDialect) CompileUpdate(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileUpdate, b)
+}
+
+func (d SQLiteDialect) CompileDelete(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileDelete, b)
+}
+
+// compilePaging writes LIMIT / OFFSET. SQLite requires a LIMIT before any
+// OFFSET, -1 means no limit.
+func (d SQLiteDialect) compilePaging(c compiler, b *builder) string {
+	var sb strings.Builder
+
+	if b.limit >= 0 {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", b.limit))
+	}
+	if b.offset >= 0 {
+		if b.limit < 0 {
+			sb.WriteString(" LIMIT -1")
+		}
+		sb.WriteString(fmt.Sprintf(" OFFSET %d", b.offset))
+	}
+
+	return sb.String()
+}
+
+// compileConflictClause compiles ON CONFLICT, SQLite has no named
+// constraint target.
+func (d SQLiteDialect) compileConflictClause(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	if b.conflict.constraint != "" {
+		return "", unsupportedFeature("ON CONFLICT ON CONSTRAINT")
+	}
+
+	return c.compileConflictClause(b.conflict, globalArgs)
+}
+
+// compileUpdateFrom compiles the FROM clause of a join-update, which was
+// added in SQLite 3.33.
+func (d SQLiteDialect) compileUpdateFrom(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	if len(b.using) > 0 && !d.atLeast(3033000) {
+		return "", unsupportedFeature("UPDATE FROM")
+	}
+
+	return c.compileUpdateFrom(b, globalArgs)
+}
@@ -0,0 +1,875 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteDialect_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		dialect                 SQLiteDialect
+		expectedArrayJoin       bool
+		expectedDistinctOn      bool
+		expectedExcept          bool
+		expectedFinal           bool
+		expectedFullJoin        bool
+		expectedIntersect       bool
+		expectedJoinUsing       bool
+		expectedLateral         bool
+		expectedLimitBy         bool
+		expectedMaterializedCTE bool
+		expectedNaturalJoin     bool
+		expectedPreWhere        bool
+		expectedReturning       bool
+		expectedRightJoin       bool
+		expectedSample          bool
+		expectedUpsert          bool
+	}{
+		{
+			name:                    "should return every capability for the latest SQLite",
+			dialect:                 SQLiteDialect{},
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        true,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         false,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: true,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       true,
+			expectedRightJoin:       true,
+			expectedSample:          false,
+			expectedUpsert:          true,
+		},
+		{
+			name:                    "should support RETURNING but not RIGHT/FULL JOIN on 3.35",
+			dialect:                 SQLiteDialect{Version: 3035000},
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        false,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         false,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: true,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       true,
+			expectedRightJoin:       false,
+			expectedSample:          false,
+			expectedUpsert:          true,
+		},
+		{
+			name:                    "should support only upserts on 3.24",
+			dialect:                 SQLiteDialect{Version: 3024000},
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        false,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         false,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: false,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       false,
+			expectedRightJoin:       false,
+			expectedSample:          false,
+			expectedUpsert:          true,
+		},
+		{
+			name:                    "should not support upserts before 3.24",
+			dialect:                 SQLiteDialect{Version: 3022000},
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        false,
+			expectedIntersect:       true,
+			expectedJoinUsing:       true,
+			expectedLateral:         false,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: false,
+			expectedNaturalJoin:     true,
+			expectedPreWhere:        false,
+			expectedReturning:       false,
+			expectedRightJoin:       false,
+			expectedSample:          false,
+			expectedUpsert:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := tt.dialect
+
+			// Act
+			caps := d.Capabilities()
+
+			// Assert
+			assert.Equal(t, tt.expectedArrayJoin, caps.SupportsArrayJoin, "expected SupportsArrayJoin to match")
+			assert.Equal(t, tt.expectedDistinctOn, caps.SupportsDistinctOn, "expected SupportsDistinctOn to match")
+			assert.Equal(t, tt.expectedExcept, caps.SupportsExcept, "expected SupportsExcept to match")
+			assert.Equal(t, tt.expectedFinal, caps.SupportsFinal, "expected SupportsFinal to match")
+			assert.Equal(t, tt.expectedFullJoin, caps.SupportsFullJoin, "expected SupportsFullJoin to match")
+			assert.Equal(t, tt.expectedIntersect, caps.SupportsIntersect, "expected SupportsIntersect to match")
+			assert.Equal(t, tt.expectedJoinUsing, caps.SupportsJoinUsing, "expected SupportsJoinUsing to match")
+			assert.Equal(t, tt.expectedLateral, caps.SupportsLateral, "expected SupportsLateral to match")
+			assert.Equal(t, tt.expectedLimitBy, caps.SupportsLimitBy, "expected SupportsLimitBy to match")
+			assert.Equal(t, tt.expectedMaterializedCTE, caps.SupportsMaterializedCTE, "expected SupportsMaterializedCTE to match")
+			assert.Equal(t, tt.expectedNaturalJoin, caps.SupportsNaturalJoin, "expected SupportsNaturalJoin to match")
+			assert.Equal(t, tt.expectedPreWhere, caps.SupportsPreWhere, "expected SupportsPreWhere to match")
+			assert.Equal(t, tt.expectedReturning, caps.SupportsReturning, "expected SupportsReturning to match")
+			assert.Equal(t, tt.expectedRightJoin, caps.SupportsRightJoin, "expected SupportsRightJoin to match")
+			assert.Equal(t, tt.expectedSample, caps.SupportsSample, "expected SupportsSample to match")
+			assert.Equal(t, tt.expectedUpsert, caps.SupportsUpsert, "expected SupportsUpsert to match")
+		})
+	}
+}
+
+func TestSQLiteDialect_Placeholder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		dialect  SQLiteDialect
+		n        int
+		expected string
+	}{
+		{
+			name:     "should return ? for first placeholder",
+			dialect:  SQLiteDialect{},
+			n:        1,
+			expected: "?",
+		},
+		{
+			name:     "should return ? for tenth placeholder",
+			dialect:  SQLiteDialect{},
+			n:        10,
+			expected: "?",
+		},
+		{
+			name:     "should return ?1 for first numbered placeholder",
+			dialect:  SQLiteDialect{NumberedPlaceholders: true},
+			n:        1,
+			expected: "?1",
+		},
+		{
+			name:     "should return ?10 for tenth numbered placeholder",
+			dialect:  SQLiteDialect{NumberedPlaceholders: true},
+			n:        10,
+			expected: "?10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := tt.dialect
+
+			// Act
+			result := d.Placeholder(tt.n)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected placeholder to match")
+		})
+	}
+}
+
+func TestSQLiteDialect_SupportsOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept ==",
+			operator: "==",
+			expected: true,
+		},
+		{
+			name:     "should accept glob",
+			operator: "glob",
+			expected: true,
+		},
+		{
+			name:     "should accept IS NOT",
+			operator: "IS NOT",
+			expected: true,
+		},
+		{
+			name:     "should reject ILIKE",
+			operator: "ILIKE",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLiteDialect{}
+
+			// Act
+			result := d.SupportsOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
+func TestSQLiteDialect_WrapColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote simple column without alias",
+			input:    "id",
+			expected: `"id"`,
+		},
+		{
+			name:     "should quote table.column with alias",
+			input:    "users.id AS user_id",
+			expected: `"users"."id" AS "user_id"`,
+		},
+		{
+			name:     "should double embedded quotes",
+			input:    `user"name`,
+			expected: `"user""name"`,
+		},
+		{
+			name:     "should keep a bare star",
+			input:    "*",
+			expected: "*",
+		},
+		{
+			name:     "should keep a qualified star unquoted",
+			input:    "users.*",
+			expected: `"users".*`,
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLiteDialect{}
+
+			// Act
+			result := d.WrapColumn(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted column to match")
+		})
+	}
+}
+
+func TestSQLiteDialect_WrapTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote table without alias",
+			input:    "users",
+			expected: `"users"`,
+		},
+		{
+			name:     "should quote attached database table with alias",
+			input:    "main.users u",
+			expected: `"main"."users" AS "u"`,
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLiteDialect{}
+
+			// Act
+			result := d.WrapTable(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted table to match")
+		})
+	}
+}
+
+func TestSQLiteDialect_Select(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       SQLiteDialect
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build select with where, order and limit",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id", "name").
+					From("users").
+					Where("active", "=", true).
+					WhereIn("role", "admin", "owner").
+					OrderBy("id", "desc").
+					Limit(10)
+			},
+			expectedSQL:  `SELECT "id", "name" FROM "users" WHERE "active" = ? AND "role" IN (?, ?) ORDER BY "id" DESC LIMIT 10`,
+			expectedArgs: []any{true, "admin", "owner"},
+		},
+		{
+			name:    "should renumber numbered placeholders inside subqueries",
+			dialect: SQLiteDialect{NumberedPlaceholders: true},
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Where("active", "=", true).
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").From("orders").Where("total", ">", 100)
+					}).
+					Where("age", ">", 18)
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE "active" = ?1 AND "id" IN (SELECT "user_id" FROM "orders" WHERE "total" > ?2) AND "age" > ?3`,
+			expectedArgs: []any{true, 100, 18},
+		},
+		{
+			name:    "should reuse a numbered placeholder for a repeated named arg",
+			dialect: SQLiteDialect{NumberedPlaceholders: true},
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Where("active", "=", true).
+					WhereRaw("owner = :owner OR editor = :owner", Named("owner", "ann"))
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE "active" = ?1 AND owner = ?2 OR editor = ?2`,
+			expectedArgs: []any{true, "ann"},
+		},
+		{
+			name: "should bind a repeated named arg once per positional marker",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					WhereRaw("owner = :owner OR editor = :owner", Named("owner", "ann"))
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE owner = ? OR editor = ?`,
+			expectedArgs: []any{"ann", "ann"},
+		},
+		{
+			name: "should build RIGHT JOIN on recent versions",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("u.id").
+					From("users u").
+					RightJoin("orders o", "o.user_id", "=", "u.id")
+			},
+			expectedSQL:  `SELECT "u"."id" FROM "users" AS "u" RIGHT JOIN "orders" AS "o" ON "o"."user_id" = "u"."id"`,
+			expectedArgs: []any{},
+		},
+		{
+			name:    "should return error for RIGHT JOIN before 3.39",
+			dialect: SQLiteDialect{Version: 3038000},
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("u.id").
+					From("users u").
+					RightJoin("orders o", "o.user_id", "=", "u.id")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return error for DISTINCT ON",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					DistinctOn("user_id").
+					From("orders")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(tt.dialect))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLiteDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		table        string
+		limit        int
+		offset       int
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name:         "should build select with limit",
+			table:        "users",
+			limit:        10,
+			offset:       -1, // default
+			expectedSQL:  `SELECT * FROM "users" LIMIT 10`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should add LIMIT -1 when only offset is set",
+			table:        "users",
+			limit:        -1, // default
+			offset:       5,
+			expectedSQL:  `SELECT * FROM "users" LIMIT -1 OFFSET 5`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should build select with limit and offset",
+			table:        "users",
+			limit:        10,
+			offset:       5,
+			expectedSQL:  `SELECT * FROM "users" LIMIT 10 OFFSET 5`,
+			expectedArgs: []any{},
+		},
+		{
+			name:         "should ignore negative limit and offset",
+			table:        "users",
+			limit:        -10,
+			offset:       -5,
+			expectedSQL:  `SELECT * FROM "users"`,
+			expectedArgs: []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{
+				dialect: SQLiteDialect{},
+				action:  "select",
+				table: table{
+					queryType: QueryBasic,
+					name:      tt.table,
+				},
+				limit:  tt.limit,
+				offset: tt.offset,
+			}
+
+			// Act
+			sql, args, err := b.dialect.CompileSelect(b)
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLiteDialect_Union(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       SQLiteDialect
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build compound select without parentheses",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Where("status", "=", "active").
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins").Where("level", ">", 2)
+					}).
+					Except(func(q QueryBuilder) {
+						q.Select("id").From("banned")
+					}).
+					OrderBy("id", "asc")
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE "status" = ? UNION SELECT "id" FROM "admins" WHERE "level" > ? EXCEPT SELECT "id" FROM "banned" ORDER BY "id" ASC`,
+			expectedArgs: []any{"active", 2},
+		},
+		{
+			name:    "should renumber numbered placeholders across branches",
+			dialect: SQLiteDialect{NumberedPlaceholders: true},
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Where("status", "=", "active").
+					UnionAll(func(q QueryBuilder) {
+						q.Select("id").From("admins").Where("level", ">", 2)
+					})
+			},
+			expectedSQL:  `SELECT "id" FROM "users" WHERE "status" = ?1 UNION ALL SELECT "id" FROM "admins" WHERE "level" > ?2`,
+			expectedArgs: []any{"active", 2},
+		},
+		{
+			name: "should return an error for a member with its own order by",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins").OrderBy("id", "desc")
+					})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return an error for a member with its own limit",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins").Limit(5)
+					})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should return an error for a member with its own offset",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Except(func(q QueryBuilder) {
+						q.Select("id").From("admins").Offset(10)
+					})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(tt.dialect))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLiteDialect_Insert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       SQLiteDialect
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build multi-row insert with RETURNING",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("users").
+					Columns("name", "age").
+					Values("John", 30).
+					Values("Jane", 25).
+					Returning("id")
+			},
+			expectedSQL:  `INSERT INTO "users" ("name", "age") VALUES (?, ?), (?, ?) RETURNING "id"`,
+			expectedArgs: []any{"John", 30, "Jane", 25},
+		},
+		{
+			name: "should build upsert with excluded columns",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("users").
+					Columns("email", "name").
+					Values("john@example.com", "John").
+					OnConflict("email").
+					DoUpdateSetExcluded("name").
+					DoUpdateWhere(func(q QueryBuilder) {
+						q.Where("users.locked", "=", false)
+					})
+			},
+			expectedSQL:  `INSERT INTO "users" ("email", "name") VALUES (?, ?) ON CONFLICT ("email") DO UPDATE SET "name" = EXCLUDED."name" WHERE "users"."locked" = ?`,
+			expectedArgs: []any{"john@example.com", "John", false},
+		},
+		{
+			name: "should return error for named constraint target",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflictConstraint("users_email_key").
+					DoNothing()
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name:    "should return error for RETURNING before 3.35",
+			dialect: SQLiteDialect{Version: 3034000},
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("users").
+					Columns("name").
+					Values("John").
+					Returning("id")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(tt.dialect))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLiteDialect_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		dialect       SQLiteDialect
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build update from another table",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("orders").
+					UpdateFrom("users").
+					Set("status", "vip").
+					WhereRaw(`"orders"."user_id" = "users"."id"`).
+					Where("users.tier", "=", "gold")
+			},
+			expectedSQL:  `UPDATE "orders" SET "status" = ? FROM "users" WHERE "orders"."user_id" = "users"."id" AND "users"."tier" = ?`,
+			expectedArgs: []any{"vip", "gold"},
+		},
+		{
+			name:    "should return error for UPDATE FROM before 3.33",
+			dialect: SQLiteDialect{Version: 3032000},
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("orders").
+					UpdateFrom("users").
+					Set("status", "vip").
+					WhereRaw(`"orders"."user_id" = "users"."id"`)
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(tt.dialect))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLiteDialect_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build delete with RETURNING",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					DeleteFrom("sessions").
+					Where("expires_at", "<", "2024-01-01").
+					Returning()
+			},
+			expectedSQL:  `DELETE FROM "sessions" WHERE "expires_at" < ? RETURNING *`,
+			expectedArgs: []any{"2024-01-01"},
+		},
+		{
+			name: "should return error for joined delete",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					DeleteFrom("sessions").
+					Using("users").
+					Where("users.banned", "=", true)
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(SQLiteDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkSQLiteDialect_Select(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: SQLiteDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Select("id", "name").
+			From("users").
+			Where("active", "=", true).
+			WhereIn("role", "admin", "owner").
+			OrderBy("id", "desc").
+			Offset(20)
+
+		_, _, _ = bd.dialect.CompileSelect(bd)
+	}
+}
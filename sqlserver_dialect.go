@@ -0,0 +1,224 @@
+package sequel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var sqlServerOperators = comparisonOperators.with(
+	"!<", "!>",
+)
+
+// SQLServerDialect targets SQL Server. IdentifierPattern, when set, must match
+// every part of an identifier.
+type SQLServerDialect struct {
+	IdentifierPattern *regexp.Regexp
+}
+
+func (d SQLServerDialect) Capabilities() DialectCapabilities {
+	return DialectCapabilities{
+		SupportsArrayJoin:       false,
+		SupportsDistinctOn:      false,
+		SupportsExcept:          true,
+		SupportsFinal:           false,
+		SupportsFullJoin:        true,
+		SupportsIntersect:       true,
+		SupportsJoinUsing:       false,
+		SupportsLateral:         false,
+		SupportsLimitBy:         false,
+		SupportsMaterializedCTE: false,
+		SupportsNaturalJoin:     false,
+		SupportsPreWhere:        false,
+		SupportsReturning:       true, // compiled as OUTPUT
+		SupportsRightJoin:       true,
+		SupportsSample:          false,
+		SupportsUpsert:          false,
+	}
+}
+
+func (d SQLServerDialect) Placeholder(n int) string {
+	return fmt.Sprintf("@p%d", n)
+}
+
+func (d SQLServerDialect) SupportsOperator(operator string) bool {
+	return sqlServerOperators.has(operator)
+}
+
+func (d SQLServerDialect) ValidIdentifier(id string) bool {
+	return validIdentifier(id, d.IdentifierPattern)
+}
+
+func (d SQLServerDialect) WrapColumn(expr string) string {
+	return brackets.column(expr)
+}
+
+func (d SQLServerDialect) WrapIdentifier(id string) string {
+	return brackets.identifier(id)
+}
+
+func (d SQLServerDialect) WrapTable(expr string) string {
+	return brackets.table(expr)
+}
+
+func (d SQLServerDialect) compiler() compiler {
+	return compiler{
+		dialect:           d,
+		omitRecursive:     true,
+		reusePlaceholders: true,
+		top:               d.compileTop,
+		paging:            d.compilePaging,
+		output:            d.compileOutputClause,
+		updateTarget:      d.compileUpdateTarget,
+		updateFrom:        d.compileDMLFromClause,
+		deleteTarget:      d.compileDeleteTarget,
+		deleteFrom:        d.compileDMLFromClause,
+	}
+}
+
+func (d SQLServerDialect) CompileSelect(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileSelect, b)
+}
+
+func (d SQLServerDialect) CompileInsert(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileInsert, b)
+}
+
+func (d SQLServerDialect) CompileUpdate(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileUpdate, b)
+}
+
+func (d SQLServerDialect) CompileDelete(b *builder) (string, []any, error) {
+	return compileStatement(d.compiler().compileDelete, b)
+}
+
+// useTop reports whether the limit is written as TOP. TOP only covers the
+// first branch of a compound query, so it is used for a bare Limit() and
+// OFFSET ... FETCH for everything else.
+func (d SQLServerDialect) useTop(b *builder) bool {
+	return b.limit >= 0 && b.offset < 0 && len(b.unions) == 0
+}
+
+func (d SQLServerDialect) compileTop(b *builder) string {
+	if !d.useTop(b) {
+		return ""
+	}
+
+	return fmt.Sprintf("TOP (%d) ", b.limit)
+}
+
+// compilePaging writes OFFSET / FETCH, which cannot be used without an
+// ORDER BY clause.
+func (d SQLServerDialect) compilePaging(c compiler, b *builder) string {
+	if d.useTop(b) || (b.limit < 0 && b.offset < 0) {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if len(b.orderBys) == 0 {
+		sb.WriteString(" ORDER BY (SELECT NULL)")
+	}
+	sb.WriteString(fmt.Sprintf(" OFFSET %d ROWS", max(b.offset, 0)))
+	if b.limit >= 0 {
+		sb.WriteString(fmt.Sprintf(" FETCH NEXT %d ROWS ONLY", b.limit))
+	}
+
+	return sb.String()
+}
+
+// compileUpdateTarget updates an aliased table through its alias and
+// declares it in the FROM clause, T-SQL has no alias after UPDATE.
+func (d SQLServerDialect) compileUpdateTarget(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	target, _ := d.dmlTarget(b.table.name)
+	return "UPDATE " + target + " SET ", nil
+}
+
+func (d SQLServerDialect) compileDeleteTarget(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	target, _ := d.dmlTarget(b.table.name)
+	return "DELETE FROM " + target, nil
+}
+
+// dmlTarget returns the quoted object named by UPDATE / DELETE: the alias
+// when the table has one, the table itself otherwise.
+func (d SQLServerDialect) dmlTarget(tbl string) (string, bool) {
+	parts := strings.Fields(tbl)
+	if len(parts) == 2 {
+		return d.WrapIdentifier(parts[1]), true
+	}
+
+	return d.WrapTable(tbl), false
+}
+
+// compileDMLFromClause compiles the FROM clause of UPDATE / DELETE. An
+// aliased target has to be declared there before the joined tables.
+func (d SQLServerDialect) compileDMLFromClause(c compiler, b *builder, globalArgs *[]any) (string, error) {
+	_, aliased := d.dmlTarget(b.table.name)
+	if !aliased && len(b.using) == 0 {
+		return "", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(" FROM ")
+
+	if aliased {
+		sb.WriteString(d.WrapTable(b.table.name))
+		if len(b.using) > 0 {
+			sb.WriteString(", ")
+		}
+	}
+
+	if len(b.using) > 0 {
+		tableList, err := c.compileTableList(b.using, globalArgs)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(tableList)
+	}
+
+	return sb.String(), nil
+}
+
+// compileOutputClause compiles RETURNING columns as OUTPUT INSERTED.col /
+// DELETED.col. Raw expressions are written as given.
+func (d SQLServerDialect) compileOutputClause(c compiler, prefix string, columns []column, globalArgs *[]any) string {
+	var sb strings.Builder
+
+	for i, col := range columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		switch col.queryType {
+		case QueryBasic:
+			// INSERTED and DELETED stand in for the table, so a qualifier
+			// such as "users." is dropped
+			name := unqualifiedColumn(col.name)
+			sb.WriteString(prefix)
+			sb.WriteString(".")
+			if name == "*" {
+				sb.WriteString("*")
+				continue
+			}
+			sb.WriteString(d.WrapColumn(name))
+		case QueryRaw:
+			sb.WriteString(c.bindRaw(col.expr, col.args, globalArgs))
+		}
+	}
+
+	return sb.String()
+}
+
+// unqualifiedColumn strips the table qualifier of a column, keeping an
+// "AS alias" suffix.
+func unqualifiedColumn(expr string) string {
+	parts := strings.Fields(expr)
+	if len(parts) == 3 && strings.EqualFold(parts[1], "as") {
+		return unqualifiedColumn(parts[0]) + " AS " + parts[2]
+	}
+
+	if i := strings.LastIndexByte(expr, '.'); i >= 0 {
+		return expr[i+1:]
+	}
+
+	return expr
+}
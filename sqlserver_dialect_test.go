@@ -0,0 +1,610 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLServerDialect_Capabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		expectedArrayJoin       bool
+		expectedDistinctOn      bool
+		expectedExcept          bool
+		expectedFinal           bool
+		expectedFullJoin        bool
+		expectedIntersect       bool
+		expectedJoinUsing       bool
+		expectedLateral         bool
+		expectedLimitBy         bool
+		expectedMaterializedCTE bool
+		expectedNaturalJoin     bool
+		expectedPreWhere        bool
+		expectedReturning       bool
+		expectedRightJoin       bool
+		expectedSample          bool
+		expectedUpsert          bool
+	}{
+		{
+			name:                    "should return correct capabilities for SQL Server",
+			expectedArrayJoin:       false,
+			expectedDistinctOn:      false,
+			expectedExcept:          true,
+			expectedFinal:           false,
+			expectedFullJoin:        true,
+			expectedIntersect:       true,
+			expectedJoinUsing:       false,
+			expectedLateral:         false,
+			expectedLimitBy:         false,
+			expectedMaterializedCTE: false,
+			expectedNaturalJoin:     false,
+			expectedPreWhere:        false,
+			expectedReturning:       true,
+			expectedRightJoin:       true,
+			expectedSample:          false,
+			expectedUpsert:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLServerDialect{}
+
+			// Act
+			caps := d.Capabilities()
+
+			// Assert
+			assert.Equal(t, tt.expectedArrayJoin, caps.SupportsArrayJoin, "expected SupportsArrayJoin to match")
+			assert.Equal(t, tt.expectedDistinctOn, caps.SupportsDistinctOn, "expected SupportsDistinctOn to match")
+			assert.Equal(t, tt.expectedExcept, caps.SupportsExcept, "expected SupportsExcept to match")
+			assert.Equal(t, tt.expectedFinal, caps.SupportsFinal, "expected SupportsFinal to match")
+			assert.Equal(t, tt.expectedFullJoin, caps.SupportsFullJoin, "expected SupportsFullJoin to match")
+			assert.Equal(t, tt.expectedIntersect, caps.SupportsIntersect, "expected SupportsIntersect to match")
+			assert.Equal(t, tt.expectedJoinUsing, caps.SupportsJoinUsing, "expected SupportsJoinUsing to match")
+			assert.Equal(t, tt.expectedLateral, caps.SupportsLateral, "expected SupportsLateral to match")
+			assert.Equal(t, tt.expectedLimitBy, caps.SupportsLimitBy, "expected SupportsLimitBy to match")
+			assert.Equal(t, tt.expectedMaterializedCTE, caps.SupportsMaterializedCTE, "expected SupportsMaterializedCTE to match")
+			assert.Equal(t, tt.expectedNaturalJoin, caps.SupportsNaturalJoin, "expected SupportsNaturalJoin to match")
+			assert.Equal(t, tt.expectedPreWhere, caps.SupportsPreWhere, "expected SupportsPreWhere to match")
+			assert.Equal(t, tt.expectedReturning, caps.SupportsReturning, "expected SupportsReturning to match")
+			assert.Equal(t, tt.expectedRightJoin, caps.SupportsRightJoin, "expected SupportsRightJoin to match")
+			assert.Equal(t, tt.expectedSample, caps.SupportsSample, "expected SupportsSample to match")
+			assert.Equal(t, tt.expectedUpsert, caps.SupportsUpsert, "expected SupportsUpsert to match")
+		})
+	}
+}
+
+func TestSQLServerDialect_Placeholder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{
+			name:     "should return @p1 for first placeholder",
+			n:        1,
+			expected: "@p1",
+		},
+		{
+			name:     "should return @p10 for tenth placeholder",
+			n:        10,
+			expected: "@p10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLServerDialect{}
+
+			// Act
+			result := d.Placeholder(tt.n)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected placeholder to match")
+		})
+	}
+}
+
+func TestSQLServerDialect_SupportsOperator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		operator string
+		expected bool
+	}{
+		{
+			name:     "should accept <>",
+			operator: "<>",
+			expected: true,
+		},
+		{
+			name:     "should accept !<",
+			operator: "!<",
+			expected: true,
+		},
+		{
+			name:     "should reject ILIKE",
+			operator: "ILIKE",
+			expected: false,
+		},
+		{
+			name:     "should reject REGEXP",
+			operator: "REGEXP",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLServerDialect{}
+
+			// Act
+			result := d.SupportsOperator(tt.operator)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected operator support to match")
+		})
+	}
+}
+
+func TestSQLServerDialect_WrapColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote simple column without alias",
+			input:    "id",
+			expected: "[id]",
+		},
+		{
+			name:     "should quote table.column with alias",
+			input:    "users.id AS user_id",
+			expected: "[users].[id] AS [user_id]",
+		},
+		{
+			name:     "should double embedded quotes",
+			input:    `user]name`,
+			expected: "[user]]name]",
+		},
+		{
+			name:     "should keep a bare star",
+			input:    "*",
+			expected: "*",
+		},
+		{
+			name:     "should keep a qualified star unquoted",
+			input:    "users.*",
+			expected: "[users].*",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLServerDialect{}
+
+			// Act
+			result := d.WrapColumn(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted column to match")
+		})
+	}
+}
+
+func TestSQLServerDialect_WrapTable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "should quote table without alias",
+			input:    "users",
+			expected: "[users]",
+		},
+		{
+			name:     "should quote schema qualified table with alias",
+			input:    "dbo.users u",
+			expected: "[dbo].[users] AS [u]",
+		},
+		{
+			name:     "should not quote empty string",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			d := SQLServerDialect{}
+
+			// Act
+			result := d.WrapTable(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected quoted table to match")
+		})
+	}
+}
+
+func TestSQLServerDialect_Select(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build select with where and joins",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("u.id", "o.total").
+					From("dbo.users u").
+					Join("orders o", "o.user_id", "=", "u.id").
+					Where("u.active", "=", true).
+					WhereBetween("o.total", 10, 100)
+			},
+			expectedSQL:  "SELECT [u].[id], [o].[total] FROM [dbo].[users] AS [u] INNER JOIN [orders] AS [o] ON [o].[user_id] = [u].[id] WHERE [u].[active] = @p1 AND ([o].[total] BETWEEN @p2 AND @p3)",
+			expectedArgs: []any{true, 10, 100},
+		},
+		{
+			name: "should reuse the placeholder of a repeated named arg",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Where("active", "=", true).
+					WhereRaw("owner = :owner OR editor = :owner", Named("owner", "ann"))
+			},
+			expectedSQL:  "SELECT [id] FROM [users] WHERE [active] = @p1 AND owner = @p2 OR editor = @p2",
+			expectedArgs: []any{true, "ann"},
+		},
+		{
+			name: "should renumber placeholders inside subqueries",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Where("active", "=", true).
+					WhereSub("id", "IN", func(q QueryBuilder) {
+						q.Select("user_id").From("orders").Where("total", ">", 100)
+					}).
+					Where("age", ">", 18)
+			},
+			expectedSQL:  "SELECT [id] FROM [users] WHERE [active] = @p1 AND [id] IN (SELECT [user_id] FROM [orders] WHERE [total] > @p2) AND [age] > @p3",
+			expectedArgs: []any{true, 100, 18},
+		},
+		{
+			name: "should build recursive CTE without RECURSIVE keyword",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					WithRecursive("tree", []string{"id", "parent_id"}, func(q QueryBuilder) {
+						q.Select("id", "parent_id").From("categories").Where("id", "=", 1)
+					}).
+					Select("id").
+					From("tree")
+			},
+			expectedSQL:  "WITH [tree] ([id], [parent_id]) AS (SELECT [id], [parent_id] FROM [categories] WHERE [id] = @p1) SELECT [id] FROM [tree]",
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should build distinct with TOP",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("country").
+					Distinct().
+					From("users").
+					Limit(5)
+			},
+			expectedSQL:  "SELECT DISTINCT TOP (5) [country] FROM [users]",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should return error for DISTINCT ON",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					DistinctOn("user_id").
+					From("orders")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(SQLServerDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLServerDialect_CompileSelect_Select_LimitOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		build        func(QueryBuilder) QueryBuilder
+		expectedSQL  string
+		expectedArgs []any
+	}{
+		{
+			name: "should use TOP when only limit is set",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.Select().From("users").Limit(10)
+			},
+			expectedSQL:  "SELECT TOP (10) * FROM [users]",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should use TOP with order by",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.Select("id").From("users").OrderBy("id", "desc").Limit(10)
+			},
+			expectedSQL:  "SELECT TOP (10) [id] FROM [users] ORDER BY [id] DESC",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should use OFFSET FETCH with order by",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.Select("id").From("users").OrderBy("id", "asc").Limit(10).Offset(20)
+			},
+			expectedSQL:  "SELECT [id] FROM [users] ORDER BY [id] ASC OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should inject ORDER BY (SELECT NULL) when order by is missing",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.Select("id").From("users").Limit(10).Offset(20)
+			},
+			expectedSQL:  "SELECT [id] FROM [users] ORDER BY (SELECT NULL) OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should use OFFSET without FETCH when only offset is set",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.Select("id").From("users").OrderBy("id", "asc").Offset(5)
+			},
+			expectedSQL:  "SELECT [id] FROM [users] ORDER BY [id] ASC OFFSET 5 ROWS",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should use OFFSET FETCH instead of TOP for unions",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Select("id").
+					From("users").
+					Union(func(q QueryBuilder) {
+						q.Select("id").From("admins")
+					}).
+					Limit(10)
+			},
+			expectedSQL:  "SELECT [id] FROM [users] UNION (SELECT [id] FROM [admins]) ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+			expectedArgs: []any{},
+		},
+		{
+			name: "should ignore negative limit and offset",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.Select().From("users")
+			},
+			expectedSQL:  "SELECT * FROM [users]",
+			expectedArgs: []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(SQLServerDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+func TestSQLServerDialect_Output(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		build         func(QueryBuilder) QueryBuilder
+		expectedSQL   string
+		expectedArgs  []any
+		expectedError error
+	}{
+		{
+			name: "should build insert with OUTPUT before VALUES",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("users").
+					Columns("name", "email").
+					Values("John", "john@example.com").
+					Values("Jane", "jane@example.com").
+					Returning("id", "created_at")
+			},
+			expectedSQL:  "INSERT INTO [users] ([name], [email]) OUTPUT INSERTED.[id], INSERTED.[created_at] VALUES (@p1, @p2), (@p3, @p4)",
+			expectedArgs: []any{"John", "john@example.com", "Jane", "jane@example.com"},
+		},
+		{
+			name: "should build update with OUTPUT all columns",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("users").
+					Set("name", "John").
+					Where("id", "=", 1).
+					Returning()
+			},
+			expectedSQL:  "UPDATE [users] SET [name] = @p1 OUTPUT INSERTED.* WHERE [id] = @p2",
+			expectedArgs: []any{"John", 1},
+		},
+		{
+			name: "should build update through alias with joined table",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("orders o").
+					UpdateFrom("users u").
+					SetRaw("[o].[status] = ?", "vip").
+					WhereRaw("[o].[user_id] = [u].[id]").
+					Where("u.tier", "=", "gold")
+			},
+			expectedSQL:  "UPDATE [o] SET [o].[status] = @p1 FROM [orders] AS [o], [users] AS [u] WHERE [o].[user_id] = [u].[id] AND [u].[tier] = @p2",
+			expectedArgs: []any{"vip", "gold"},
+		},
+		{
+			name: "should drop table qualifiers from OUTPUT columns",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Update("users").
+					Set("name", "John").
+					Where("id", "=", 1).
+					Returning("users.name", "users.id AS user_id", "users.*")
+			},
+			expectedSQL:  "UPDATE [users] SET [name] = @p1 OUTPUT INSERTED.[name], INSERTED.[id] AS [user_id], INSERTED.* WHERE [id] = @p2",
+			expectedArgs: []any{"John", 1},
+		},
+		{
+			name: "should build delete with OUTPUT DELETED and raw expression",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					DeleteFrom("sessions").
+					Where("expires_at", "<", "2024-01-01").
+					Returning("id").
+					ReturningRaw("DELETED.user_id AS owner")
+			},
+			expectedSQL:  "DELETE FROM [sessions] OUTPUT DELETED.[id], DELETED.user_id AS owner WHERE [expires_at] < @p1",
+			expectedArgs: []any{"2024-01-01"},
+		},
+		{
+			name: "should build joined delete",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					DeleteFrom("sessions").
+					Using("users").
+					WhereRaw("[sessions].[user_id] = [users].[id]").
+					Where("users.banned", "=", true)
+			},
+			expectedSQL:  "DELETE FROM [sessions] FROM [users] WHERE [sessions].[user_id] = [users].[id] AND [users].[banned] = @p1",
+			expectedArgs: []any{true},
+		},
+		{
+			name: "should return error for upserts",
+			build: func(q QueryBuilder) QueryBuilder {
+				return q.
+					Insert("users").
+					Columns("email").
+					Values("john@example.com").
+					OnConflict("email").
+					DoNothing()
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := tt.build(New(SQLServerDialect{}))
+
+			// Act
+			sql, args, err := q.ToSQL()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError, "expected error to match")
+				assert.Empty(t, sql, "expected empty SQL on error")
+				assert.Empty(t, args, "expected empty args on error")
+				return
+			}
+
+			assert.NoError(t, err, "expected no error")
+			assert.Equal(t, tt.expectedSQL, sql, "expected SQL to match output")
+			assert.Equal(t, tt.expectedArgs, args, "expected args to match output")
+		})
+	}
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkSQLServerDialect_Select(b *testing.B) {
+	for b.Loop() {
+		bd := &builder{
+			dialect: SQLServerDialect{},
+			limit:   -1,
+			offset:  -1,
+		}
+
+		bd.Select("id", "name").
+			From("users").
+			Where("active", "=", true).
+			WhereIn("role", "admin", "owner").
+			OrderBy("id", "desc").
+			Limit(10).
+			Offset(20)
+
+		_, _, _ = bd.dialect.CompileSelect(bd)
+	}
+}
@@ -9,7 +9,43 @@ func (b *builder) ToSQL() (string, []any, error) {
 	case "select":
 		return b.dialect.CompileSelect(b)
 
+	case "insert":
+		return b.dialect.CompileInsert(b)
+
+	case "update":
+		return b.dialect.CompileUpdate(b)
+
+	case "delete":
+		return b.dialect.CompileDelete(b)
+
 	default:
 		return "", nil, ErrUnsupportedAction
 	}
 }
+
+// compile is ToSQL for nested builders: the statement is written into
+// globalArgs instead of a fresh args list.
+func (b *builder) compile(globalArgs *[]any) (string, error) {
+	if b.dialect == nil {
+		return "", ErrNoDialect
+	}
+
+	c := b.dialect.compiler()
+
+	switch b.action {
+	case "select":
+		return c.compileSelect(b, globalArgs)
+
+	case "insert":
+		return c.compileInsert(b, globalArgs)
+
+	case "update":
+		return c.compileUpdate(b, globalArgs)
+
+	case "delete":
+		return c.compileDelete(b, globalArgs)
+
+	default:
+		return "", ErrUnsupportedAction
+	}
+}
@@ -34,11 +34,73 @@ func TestBuilder_ToSQL(t *testing.T) {
 			expectedSQL:  `SELECT * FROM "users" WHERE "id" = $1`,
 			expectedArgs: []any{1},
 		},
+		{
+			name: "should return insert SQL when action is insert",
+			builder: builder{
+				dialect: PostgresDialect{},
+				action:  "insert",
+				table: table{
+					queryType: QueryBasic,
+					name:      "users",
+				},
+				insertColumns: []string{"name"},
+				values:        [][]any{{"John"}},
+			},
+			expectedSQL:  `INSERT INTO "users" ("name") VALUES ($1)`,
+			expectedArgs: []any{"John"},
+		},
+		{
+			name: "should return update SQL when action is update",
+			builder: builder{
+				dialect: PostgresDialect{},
+				action:  "update",
+				table: table{
+					queryType: QueryBasic,
+					name:      "users",
+				},
+				sets: []set{
+					{queryType: QueryBasic, column: "name", args: []any{"John"}},
+				},
+				wheres: []where{
+					{queryType: QueryBasic, column: "id", operator: "=", args: []any{1}},
+				},
+			},
+			expectedSQL:  `UPDATE "users" SET "name" = $1 WHERE "id" = $2`,
+			expectedArgs: []any{"John", 1},
+		},
+		{
+			name: "should return delete SQL when action is delete",
+			builder: builder{
+				dialect: PostgresDialect{},
+				action:  "delete",
+				table: table{
+					queryType: QueryBasic,
+					name:      "users",
+				},
+				wheres: []where{
+					{queryType: QueryBasic, column: "id", operator: "=", args: []any{1}},
+				},
+			},
+			expectedSQL:  `DELETE FROM "users" WHERE "id" = $1`,
+			expectedArgs: []any{1},
+		},
+		{
+			name: "should return error when delete has no where clause",
+			builder: builder{
+				dialect: PostgresDialect{},
+				action:  "delete",
+				table: table{
+					queryType: QueryBasic,
+					name:      "users",
+				},
+			},
+			expectedError: ErrUnfilteredDelete,
+		},
 		{
 			name: "should return error when action is unsupported",
 			builder: builder{
 				dialect: PostgresDialect{},
-				action:  "update", // unsupported
+				action:  "merge", // unsupported
 				table: table{
 					queryType: QueryBasic,
 					name:      "users",
@@ -58,6 +120,63 @@ func TestBuilder_ToSQL(t *testing.T) {
 			},
 			expectedError: ErrNoDialect,
 		},
+		{
+			name: "should number subquery placeholders after the outer args",
+			builder: builder{
+				dialect: PostgresDialect{},
+				action:  "select",
+				table: table{
+					queryType: QueryBasic,
+					name:      "users",
+				},
+				limit:  -1,
+				offset: -1,
+				wheres: []where{
+					{queryType: QueryBasic, conj: "AND", column: "active", operator: "=", args: []any{true}},
+					{
+						queryType: QuerySub, conj: "AND", column: "id", operator: "IN", sub: &builder{
+							dialect: PostgresDialect{},
+							action:  "select",
+							columns: []column{{queryType: QueryBasic, name: "user_id"}},
+							table: table{
+								queryType: QueryBasic,
+								name:      "orders",
+							},
+							limit:  -1,
+							offset: -1,
+							wheres: []where{
+								{queryType: QueryBasic, conj: "AND", column: "amount", operator: ">", args: []any{100}},
+							},
+						},
+					},
+				},
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "active" = $1 AND "id" IN (SELECT "user_id" FROM "orders" WHERE "amount" > $2)`,
+			expectedArgs: []any{true, 100},
+		},
+		{
+			name: "should return error when subquery dialect is nil",
+			builder: builder{
+				dialect: PostgresDialect{},
+				action:  "select",
+				table: table{
+					queryType: QuerySub,
+					name:      "t",
+					sub: &builder{
+						action: "select",
+						table: table{
+							queryType: QueryBasic,
+							name:      "users",
+						},
+						limit:  -1,
+						offset: -1,
+					},
+				},
+				limit:  -1,
+				offset: -1,
+			},
+			expectedError: ErrNoDialect,
+		},
 		{
 			name: "should return error when nested where has an error",
 			builder: builder{
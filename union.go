@@ -0,0 +1,53 @@
+package sequel
+
+func (b *builder) Union(fn func(QueryBuilder)) QueryBuilder {
+	b.addUnion("UNION", fn)
+	return b
+}
+
+func (b *builder) UnionAll(fn func(QueryBuilder)) QueryBuilder {
+	b.addUnion("UNION ALL", fn)
+	return b
+}
+
+func (b *builder) Intersect(fn func(QueryBuilder)) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsIntersect {
+		b.addErr(unsupportedFeature("INTERSECT"))
+		return b
+	}
+
+	b.addUnion("INTERSECT", fn)
+	return b
+}
+
+func (b *builder) Except(fn func(QueryBuilder)) QueryBuilder {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsExcept {
+		b.addErr(unsupportedFeature("EXCEPT"))
+		return b
+	}
+
+	b.addUnion("EXCEPT", fn)
+	return b
+}
+
+func (b *builder) addUnion(operator string, fn func(QueryBuilder)) {
+	if fn == nil {
+		b.addErr(ErrNilFunc)
+		return
+	}
+
+	subBuilder := New(b.dialect).(*builder)
+	subBuilder.action = "select"
+	fn(subBuilder)
+
+	// propagate child error
+	if subBuilder.err != nil {
+		b.addErr(subBuilder.err)
+		return
+	}
+
+	b.unions = append(b.unions, union{
+		operator: operator,
+		sub:      subBuilder,
+	})
+}
@@ -0,0 +1,117 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Union(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		dialect           Dialect
+		method            func(*builder, func(QueryBuilder)) QueryBuilder
+		fn                func(QueryBuilder)
+		expectedOperators []string
+		expectedError     error
+	}{
+		{
+			name:   "should add UNION branch",
+			method: (*builder).Union,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("admins")
+			},
+			expectedOperators: []string{"UNION"},
+		},
+		{
+			name:   "should add UNION ALL branch",
+			method: (*builder).UnionAll,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("admins")
+			},
+			expectedOperators: []string{"UNION ALL"},
+		},
+		{
+			name:    "should add INTERSECT branch",
+			dialect: PostgresDialect{},
+			method:  (*builder).Intersect,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("admins")
+			},
+			expectedOperators: []string{"INTERSECT"},
+		},
+		{
+			name:    "should add EXCEPT branch",
+			dialect: PostgresDialect{},
+			method:  (*builder).Except,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("admins")
+			},
+			expectedOperators: []string{"EXCEPT"},
+		},
+		{
+			name:          "should return error when function is nil",
+			method:        (*builder).Union,
+			fn:            nil,
+			expectedError: ErrNilFunc,
+		},
+		{
+			name:   "should propagate error from branch builder",
+			method: (*builder).UnionAll,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("")
+			},
+			expectedError: ErrEmptyTable,
+		},
+		{
+			name:    "should return error when dialect does not support INTERSECT",
+			dialect: limitedDialect{},
+			method:  (*builder).Intersect,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("admins")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name:    "should return error when dialect does not support EXCEPT",
+			dialect: limitedDialect{},
+			method:  (*builder).Except,
+			fn: func(q QueryBuilder) {
+				q.Select("id").From("admins")
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := tt.method(b, tt.fn)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+				assert.Empty(t, b.unions, "expected no branch to be added on error")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			operators := []string{}
+			for _, u := range b.unions {
+				operators = append(operators, u.operator)
+				assert.Equal(t, "select", u.sub.(*builder).action, "expected branch to be a select")
+			}
+			if tt.expectedOperators != nil {
+				assert.Equal(t, tt.expectedOperators, operators, "expected branch operators to match")
+			}
+			assert.Equal(t, b, result, "expected method to return the same builder instance")
+		})
+	}
+}
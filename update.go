@@ -0,0 +1,141 @@
+package sequel
+
+import (
+	"sort"
+)
+
+func (b *builder) Update(tbl string) QueryBuilder {
+	b.action = "update"
+
+	if tbl == "" {
+		b.addErr(ErrEmptyTable)
+		return b
+	}
+
+	if !b.validTable(tbl) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
+	b.table = table{
+		queryType: QueryBasic,
+		name:      tbl,
+	}
+
+	return b
+}
+
+func (b *builder) Set(column string, value any) QueryBuilder {
+	b.addSet(column, value)
+	return b
+}
+
+func (b *builder) SetMap(values map[string]any) QueryBuilder {
+	if len(values) == 0 {
+		b.addErr(ErrEmptyValues)
+		return b
+	}
+
+	// Sort keys so the generated SQL is stable across runs
+	columns := make([]string, 0, len(values))
+	for col := range values {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+
+	for _, col := range columns {
+		b.addSet(col, values[col])
+	}
+
+	return b
+}
+
+func (b *builder) SetRaw(expr string, args ...any) QueryBuilder {
+	if expr == "" {
+		b.addErr(ErrEmptyExpression)
+		return b
+	}
+
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
+	b.sets = append(b.sets, set{
+		queryType: QueryRaw,
+		expr:      expr,
+		args:      args,
+	})
+
+	return b
+}
+
+func (b *builder) UpdateFrom(tbl string) QueryBuilder {
+	if tbl == "" {
+		b.addErr(ErrEmptyTable)
+		return b
+	}
+
+	if !b.validTable(tbl) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
+	b.using = append(b.using, table{
+		queryType: QueryBasic,
+		name:      tbl,
+	})
+
+	return b
+}
+
+func (b *builder) AllowFullTable() QueryBuilder {
+	b.allowFullTable = true
+	return b
+}
+
+func (b *builder) addSet(column string, value any) {
+	s, ok := b.newSet(column, value)
+	if !ok {
+		return
+	}
+
+	// Setting the same column twice keeps the latest value
+	for i, existing := range b.sets {
+		if existing.queryType != QueryRaw && existing.column == column {
+			b.sets[i] = s
+			return
+		}
+	}
+
+	b.sets = append(b.sets, s)
+}
+
+// newSet builds the assignment of value to column. A Col or Raw value is
+// written in place of the bind parameter.
+func (b *builder) newSet(column string, value any) (set, bool) {
+	if column == "" {
+		b.addErr(ErrEmptyColumn)
+		return set{}, false
+	}
+
+	if !b.validIdentifier(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return set{}, false
+	}
+
+	if err := b.checkValue(value); err != nil {
+		b.addErr(err)
+		return set{}, false
+	}
+
+	switch v := value.(type) {
+	case ColumnRef:
+		return set{queryType: QueryColumn, column: column, expr: v.name}, true
+
+	case Expression:
+		return set{queryType: QueryExpr, column: column, expr: v.expr, args: v.args}, true
+	}
+
+	return set{queryType: QueryBasic, column: column, args: []any{value}}, true
+}
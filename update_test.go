@@ -0,0 +1,384 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		table         string
+		expectedTable table
+		expectedError error
+	}{
+		{
+			name:  "should set action and table",
+			table: "users",
+			expectedTable: table{
+				queryType: QueryBasic,
+				name:      "users",
+			},
+		},
+		{
+			name:  "should set aliased table",
+			table: "users u",
+			expectedTable: table{
+				queryType: QueryBasic,
+				name:      "users u",
+			},
+		},
+		{
+			name:          "should return error when table is empty",
+			table:         "",
+			expectedTable: table{},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{}
+
+			// Act
+			result := b.Update(tt.table)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, "update", b.action, "expected action to be update")
+			assert.Equal(t, tt.expectedTable, b.table, "expected table to be set correctly")
+			assert.Equal(t, b, result, "expected Update() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_Set(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		initialSets   []set
+		column        string
+		value         any
+		expectedSets  []set
+		expectedError error
+	}{
+		{
+			name:   "should add a set clause",
+			column: "name",
+			value:  "John",
+			expectedSets: []set{
+				{queryType: QueryBasic, column: "name", args: []any{"John"}},
+			},
+		},
+		{
+			name: "should append a set clause for another column",
+			initialSets: []set{
+				{queryType: QueryBasic, column: "name", args: []any{"John"}},
+			},
+			column: "age",
+			value:  30,
+			expectedSets: []set{
+				{queryType: QueryBasic, column: "name", args: []any{"John"}},
+				{queryType: QueryBasic, column: "age", args: []any{30}},
+			},
+		},
+		{
+			name: "should overwrite the value of an existing column",
+			initialSets: []set{
+				{queryType: QueryBasic, column: "name", args: []any{"John"}},
+			},
+			column: "name",
+			value:  "Jane",
+			expectedSets: []set{
+				{queryType: QueryBasic, column: "name", args: []any{"Jane"}},
+			},
+		},
+		{
+			name:   "should allow nil value",
+			column: "deleted_at",
+			value:  nil,
+			expectedSets: []set{
+				{queryType: QueryBasic, column: "deleted_at", args: []any{nil}},
+			},
+		},
+		{
+			name:   "should add a column reference",
+			column: "total",
+			value:  Col("subtotal"),
+			expectedSets: []set{
+				{queryType: QueryColumn, column: "total", expr: "subtotal"},
+			},
+		},
+		{
+			name:   "should add a raw expression",
+			column: "visits",
+			value:  Raw("visits + ?", 1),
+			expectedSets: []set{
+				{queryType: QueryExpr, column: "visits", expr: "visits + ?", args: []any{1}},
+			},
+		},
+		{
+			name: "should overwrite a bound value with a raw expression",
+			initialSets: []set{
+				{queryType: QueryBasic, column: "updated_at", args: []any{"2024-01-01"}},
+			},
+			column: "updated_at",
+			value:  Raw("NOW()"),
+			expectedSets: []set{
+				{queryType: QueryExpr, column: "updated_at", expr: "NOW()"},
+			},
+		},
+		{
+			name:          "should return error when column is empty",
+			column:        "",
+			value:         "John",
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when column reference is empty",
+			column:        "total",
+			value:         Col(""),
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when raw expression is empty",
+			column:        "updated_at",
+			value:         Raw(""),
+			expectedError: ErrEmptyExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{sets: tt.initialSets}
+
+			// Act
+			result := b.Set(tt.column, tt.value)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedSets, b.sets, "expected sets to be updated correctly")
+			assert.Equal(t, b, result, "expected Set() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_SetMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        map[string]any
+		expectedSets  []set
+		expectedError error
+	}{
+		{
+			name:   "should add set clauses in sorted column order",
+			values: map[string]any{"name": "John", "age": 30, "email": "john@example.com"},
+			expectedSets: []set{
+				{queryType: QueryBasic, column: "age", args: []any{30}},
+				{queryType: QueryBasic, column: "email", args: []any{"john@example.com"}},
+				{queryType: QueryBasic, column: "name", args: []any{"John"}},
+			},
+		},
+		{
+			name:          "should return error when map is empty",
+			values:        map[string]any{},
+			expectedError: ErrEmptyValues,
+		},
+		{
+			name:          "should return error when a key is empty",
+			values:        map[string]any{"": "John"},
+			expectedError: ErrEmptyColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{}
+
+			// Act
+			result := b.SetMap(tt.values)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedSets, b.sets, "expected sets to be updated correctly")
+			assert.Equal(t, b, result, "expected SetMap() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_SetRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expr          string
+		args          []any
+		expectedSets  []set
+		expectedError error
+	}{
+		{
+			name: "should add a raw set clause with args",
+			expr: "count = count + ?",
+			args: []any{1},
+			expectedSets: []set{
+				{queryType: QueryRaw, expr: "count = count + ?", args: []any{1}},
+			},
+		},
+		{
+			name: "should add a raw set clause without args",
+			expr: "updated_at = NOW()",
+			expectedSets: []set{
+				{queryType: QueryRaw, expr: "updated_at = NOW()"},
+			},
+		},
+		{
+			name:          "should return error when expression is empty",
+			expr:          "",
+			expectedError: ErrEmptyExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{}
+
+			// Act
+			result := b.SetRaw(tt.expr, tt.args...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedSets, b.sets, "expected sets to be updated correctly")
+			assert.Equal(t, b, result, "expected SetRaw() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_UpdateFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		initialUsing  []table
+		table         string
+		expectedUsing []table
+		expectedError error
+	}{
+		{
+			name:  "should add a FROM table",
+			table: "orders",
+			expectedUsing: []table{
+				{queryType: QueryBasic, name: "orders"},
+			},
+		},
+		{
+			name: "should append another FROM table",
+			initialUsing: []table{
+				{queryType: QueryBasic, name: "orders"},
+			},
+			table: "customers c",
+			expectedUsing: []table{
+				{queryType: QueryBasic, name: "orders"},
+				{queryType: QueryBasic, name: "customers c"},
+			},
+		},
+		{
+			name:          "should return error when table is empty",
+			table:         "",
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{using: tt.initialUsing}
+
+			// Act
+			result := b.UpdateFrom(tt.table)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedUsing, b.using, "expected FROM tables to be updated correctly")
+			assert.Equal(t, b, result, "expected UpdateFrom() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_AllowFullTable(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := &builder{}
+
+	// Act
+	result := b.AllowFullTable()
+
+	// Assert
+	assert.True(t, b.allowFullTable, "expected allowFullTable to be enabled")
+	assert.Equal(t, b, result, "expected AllowFullTable() to return the same builder instance")
+}
+
+// -----------------
+// --- BENCHMARK ---
+// -----------------
+
+func BenchmarkBuilder_Set(b *testing.B) {
+	for b.Loop() {
+		builder := &builder{}
+		builder.Update("users").Set("name", "John").Set("email", "john@example.com")
+	}
+}
+
+func BenchmarkBuilder_SetMap(b *testing.B) {
+	values := map[string]any{"name": "John", "email": "john@example.com"}
+
+	for b.Loop() {
+		builder := &builder{}
+		builder.Update("users").SetMap(values)
+	}
+}
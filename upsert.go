@@ -0,0 +1,177 @@
+package sequel
+
+func (b *builder) OnConflict(columns ...string) QueryBuilder {
+	if !b.supportsUpsert() {
+		return b
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validIdentifier(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	b.conflict = &onConflict{
+		columns: append([]string(nil), columns...),
+	}
+
+	return b
+}
+
+func (b *builder) OnConflictConstraint(name string) QueryBuilder {
+	if !b.supportsUpsert() {
+		return b
+	}
+
+	if name == "" {
+		b.addErr(ErrEmptyExpression)
+		return b
+	}
+
+	if !b.validAlias(name) {
+		b.addErr(ErrInvalidIdentifier)
+		return b
+	}
+
+	b.conflict = &onConflict{
+		constraint: name,
+	}
+
+	return b
+}
+
+func (b *builder) DoNothing() QueryBuilder {
+	if b.conflict == nil {
+		b.addErr(ErrInvalidConflict)
+		return b
+	}
+
+	b.conflict.action = "NOTHING"
+	b.conflict.sets = nil
+	b.conflict.wheres = nil
+
+	return b
+}
+
+func (b *builder) DoUpdateSet(column string, value any) QueryBuilder {
+	if !b.doUpdate() {
+		return b
+	}
+
+	s, ok := b.newSet(column, value)
+	if !ok {
+		return b
+	}
+
+	b.conflict.sets = append(b.conflict.sets, s)
+
+	return b
+}
+
+func (b *builder) DoUpdateSetRaw(expr string, args ...any) QueryBuilder {
+	if !b.doUpdate() {
+		return b
+	}
+
+	if expr == "" {
+		b.addErr(ErrEmptyExpression)
+		return b
+	}
+
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return b
+	}
+
+	b.conflict.sets = append(b.conflict.sets, set{
+		queryType: QueryRaw,
+		expr:      expr,
+		args:      args,
+	})
+
+	return b
+}
+
+func (b *builder) DoUpdateSetExcluded(columns ...string) QueryBuilder {
+	if !b.doUpdate() {
+		return b
+	}
+
+	if len(columns) == 0 {
+		b.addErr(ErrEmptyColumn)
+		return b
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return b
+		}
+
+		if !b.validIdentifier(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return b
+		}
+	}
+
+	for _, col := range columns {
+		b.conflict.sets = append(b.conflict.sets, set{
+			queryType: QueryExcluded,
+			column:    col,
+		})
+	}
+
+	return b
+}
+
+func (b *builder) DoUpdateWhere(fn func(QueryBuilder)) QueryBuilder {
+	if !b.doUpdate() {
+		return b
+	}
+
+	if fn == nil {
+		b.addErr(ErrNilFunc)
+		return b
+	}
+
+	nestedBuilder := New(b.dialect).(*builder)
+	fn(nestedBuilder)
+
+	// propagate child error
+	if nestedBuilder.err != nil {
+		b.addErr(nestedBuilder.err)
+		return b
+	}
+
+	b.conflict.wheres = append(b.conflict.wheres, nestedBuilder.wheres...)
+
+	return b
+}
+
+// doUpdate switches the conflict action to DO UPDATE, reporting whether a
+// conflict target has been declared.
+func (b *builder) doUpdate() bool {
+	if b.conflict == nil {
+		b.addErr(ErrInvalidConflict)
+		return false
+	}
+
+	b.conflict.action = "UPDATE"
+
+	return true
+}
+
+func (b *builder) supportsUpsert() bool {
+	if b.dialect != nil && !b.dialect.Capabilities().SupportsUpsert {
+		b.addErr(unsupportedFeature("ON CONFLICT"))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,453 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_OnConflict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		dialect          Dialect
+		columns          []string
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:             "should set conflict target columns",
+			columns:          []string{"email"},
+			expectedConflict: &onConflict{columns: []string{"email"}},
+		},
+		{
+			name:             "should set composite conflict target",
+			columns:          []string{"tenant_id", "email"},
+			expectedConflict: &onConflict{columns: []string{"tenant_id", "email"}},
+		},
+		{
+			name:             "should allow conflict without target",
+			columns:          []string{},
+			expectedConflict: &onConflict{},
+		},
+		{
+			name:          "should return error when a column is empty",
+			columns:       []string{"email", ""},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when dialect does not support upsert",
+			dialect:       limitedDialect{},
+			columns:       []string{"email"},
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.OnConflict(tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected OnConflict() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_OnConflictConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		dialect          Dialect
+		constraint       string
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:             "should set conflict constraint",
+			constraint:       "users_email_key",
+			expectedConflict: &onConflict{constraint: "users_email_key"},
+		},
+		{
+			name:          "should return error when constraint is empty",
+			constraint:    "",
+			expectedError: ErrEmptyExpression,
+		},
+		{
+			name:          "should return error when dialect does not support upsert",
+			dialect:       limitedDialect{},
+			constraint:    "users_email_key",
+			expectedError: ErrUnsupportedFeature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := b.OnConflictConstraint(tt.constraint)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected OnConflictConstraint() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_DoNothing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initialConflict  *onConflict
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:             "should set DO NOTHING action",
+			initialConflict:  &onConflict{columns: []string{"email"}},
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "NOTHING"},
+		},
+		{
+			name: "should discard previous DO UPDATE assignments",
+			initialConflict: &onConflict{
+				columns: []string{"email"},
+				action:  "UPDATE",
+				sets:    []set{{queryType: QueryExcluded, column: "name"}},
+				wheres:  []where{{queryType: QueryRaw, conj: "AND", expr: "active"}},
+			},
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "NOTHING"},
+		},
+		{
+			name:          "should return error when OnConflict was not called",
+			expectedError: ErrInvalidConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{conflict: tt.initialConflict}
+
+			// Act
+			result := b.DoNothing()
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected DoNothing() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_DoUpdateSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initialConflict  *onConflict
+		column           string
+		value            any
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:            "should add DO UPDATE assignment",
+			initialConflict: &onConflict{columns: []string{"email"}},
+			column:          "name",
+			value:           "John",
+			expectedConflict: &onConflict{
+				columns: []string{"email"},
+				action:  "UPDATE",
+				sets:    []set{{queryType: QueryBasic, column: "name", args: []any{"John"}}},
+			},
+		},
+		{
+			name:            "should add DO UPDATE raw expression",
+			initialConflict: &onConflict{columns: []string{"email"}},
+			column:          "updated_at",
+			value:           Raw("NOW()"),
+			expectedConflict: &onConflict{
+				columns: []string{"email"},
+				action:  "UPDATE",
+				sets:    []set{{queryType: QueryExpr, column: "updated_at", expr: "NOW()"}},
+			},
+		},
+		{
+			name:            "should add DO UPDATE column reference",
+			initialConflict: &onConflict{columns: []string{"email"}},
+			column:          "name",
+			value:           Col("nickname"),
+			expectedConflict: &onConflict{
+				columns: []string{"email"},
+				action:  "UPDATE",
+				sets:    []set{{queryType: QueryColumn, column: "name", expr: "nickname"}},
+			},
+		},
+		{
+			name:             "should return error when column is empty",
+			initialConflict:  &onConflict{columns: []string{"email"}},
+			column:           "",
+			value:            "John",
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "UPDATE"},
+			expectedError:    ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when OnConflict was not called",
+			column:        "name",
+			value:         "John",
+			expectedError: ErrInvalidConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{conflict: tt.initialConflict}
+
+			// Act
+			result := b.DoUpdateSet(tt.column, tt.value)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected DoUpdateSet() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_DoUpdateSetRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initialConflict  *onConflict
+		expr             string
+		args             []any
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:            "should add raw DO UPDATE assignment",
+			initialConflict: &onConflict{columns: []string{"sku"}},
+			expr:            "stock = products.stock + ?",
+			args:            []any{5},
+			expectedConflict: &onConflict{
+				columns: []string{"sku"},
+				action:  "UPDATE",
+				sets:    []set{{queryType: QueryRaw, expr: "stock = products.stock + ?", args: []any{5}}},
+			},
+		},
+		{
+			name:             "should return error when expression is empty",
+			initialConflict:  &onConflict{columns: []string{"sku"}},
+			expr:             "",
+			expectedConflict: &onConflict{columns: []string{"sku"}, action: "UPDATE"},
+			expectedError:    ErrEmptyExpression,
+		},
+		{
+			name:          "should return error when OnConflict was not called",
+			expr:          "stock = 0",
+			expectedError: ErrInvalidConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{conflict: tt.initialConflict}
+
+			// Act
+			result := b.DoUpdateSetRaw(tt.expr, tt.args...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected DoUpdateSetRaw() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_DoUpdateSetExcluded(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initialConflict  *onConflict
+		columns          []string
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:            "should add EXCLUDED assignments for each column",
+			initialConflict: &onConflict{columns: []string{"email"}},
+			columns:         []string{"name", "updated_at"},
+			expectedConflict: &onConflict{
+				columns: []string{"email"},
+				action:  "UPDATE",
+				sets: []set{
+					{queryType: QueryExcluded, column: "name"},
+					{queryType: QueryExcluded, column: "updated_at"},
+				},
+			},
+		},
+		{
+			name:             "should return error when no columns are given",
+			initialConflict:  &onConflict{columns: []string{"email"}},
+			columns:          []string{},
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "UPDATE"},
+			expectedError:    ErrEmptyColumn,
+		},
+		{
+			name:             "should return error when a column is empty",
+			initialConflict:  &onConflict{columns: []string{"email"}},
+			columns:          []string{"name", ""},
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "UPDATE"},
+			expectedError:    ErrEmptyColumn,
+		},
+		{
+			name:          "should return error when OnConflict was not called",
+			columns:       []string{"name"},
+			expectedError: ErrInvalidConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{conflict: tt.initialConflict}
+
+			// Act
+			result := b.DoUpdateSetExcluded(tt.columns...)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected DoUpdateSetExcluded() to return the same builder instance")
+		})
+	}
+}
+
+func TestBuilder_DoUpdateWhere(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initialConflict  *onConflict
+		fn               func(QueryBuilder)
+		expectedConflict *onConflict
+		expectedError    error
+	}{
+		{
+			name:            "should add conditions to the conflict action",
+			initialConflict: &onConflict{columns: []string{"email"}},
+			fn: func(q QueryBuilder) {
+				q.Where("users.active", "=", true)
+			},
+			expectedConflict: &onConflict{
+				columns: []string{"email"},
+				action:  "UPDATE",
+				wheres: []where{
+					{queryType: QueryBasic, conj: "AND", column: "users.active", operator: "=", args: []any{true}},
+				},
+			},
+		},
+		{
+			name:             "should return error when function is nil",
+			initialConflict:  &onConflict{columns: []string{"email"}},
+			fn:               nil,
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "UPDATE"},
+			expectedError:    ErrNilFunc,
+		},
+		{
+			name:            "should propagate error from nested builder",
+			initialConflict: &onConflict{columns: []string{"email"}},
+			fn: func(q QueryBuilder) {
+				q.WhereNull("")
+			},
+			expectedConflict: &onConflict{columns: []string{"email"}, action: "UPDATE"},
+			expectedError:    ErrEmptyColumn,
+		},
+		{
+			name: "should return error when OnConflict was not called",
+			fn: func(q QueryBuilder) {
+				q.Where("active", "=", true)
+			},
+			expectedError: ErrInvalidConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: PostgresDialect{}, conflict: tt.initialConflict}
+
+			// Act
+			result := b.DoUpdateWhere(tt.fn)
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+			} else {
+				assert.NoError(t, b.err, "expected no error")
+			}
+
+			assert.Equal(t, tt.expectedConflict, b.conflict, "expected conflict to be set correctly")
+			assert.Equal(t, b, result, "expected DoUpdateWhere() to return the same builder instance")
+		})
+	}
+}
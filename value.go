@@ -0,0 +1,57 @@
+package sequel
+
+// ColumnRef is a column used as a Where, Set or Values value. It compiles to a
+// quoted identifier instead of a bind parameter.
+type ColumnRef struct {
+	name string
+}
+
+// Expression is raw SQL used as a Where, Set or Values value. Its "?" or :name
+// markers bind args like the other raw expressions.
+type Expression struct {
+	expr string
+	args []any
+}
+
+func Col(name string) ColumnRef {
+	return ColumnRef{name: name}
+}
+
+func Raw(expr string, args ...any) Expression {
+	return Expression{expr: expr, args: args}
+}
+
+// isWrapped reports whether v is a Col or Raw value. IN lists and BETWEEN
+// bounds are always bound, so they reject them.
+func isWrapped(v any) bool {
+	switch v.(type) {
+	case ColumnRef, Expression:
+		return true
+	}
+
+	return false
+}
+
+// checkValue validates a Col or Raw value written in place of a bind
+// parameter. Any other value is bound as given.
+func (b *builder) checkValue(v any) error {
+	switch v := v.(type) {
+	case ColumnRef:
+		if v.name == "" {
+			return ErrEmptyColumn
+		}
+
+		if !b.validColumn(v.name) {
+			return ErrInvalidIdentifier
+		}
+
+	case Expression:
+		if v.expr == "" {
+			return ErrEmptyExpression
+		}
+
+		return b.checkRawArgs(v.expr, v.args)
+	}
+
+	return nil
+}
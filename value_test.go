@@ -0,0 +1,52 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCol(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	result := Col("users.id")
+
+	// Assert
+	assert.Equal(t, ColumnRef{name: "users.id"}, result, "expected column reference to match")
+}
+
+func TestRaw(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expr     string
+		args     []any
+		expected Expression
+	}{
+		{
+			name:     "should keep expression without args",
+			expr:     "NOW()",
+			expected: Expression{expr: "NOW()"},
+		},
+		{
+			name:     "should keep expression with args",
+			expr:     "cost * ?",
+			args:     []any{1.2},
+			expected: Expression{expr: "cost * ?", args: []any{1.2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			result := Raw(tt.expr, tt.args...)
+
+			// Assert
+			assert.Equal(t, tt.expected, result, "expected expression to match")
+		})
+	}
+}
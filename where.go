@@ -2,7 +2,7 @@ package sequel
 
 import (
 	"reflect"
-	"strings"
+	"slices"
 )
 
 func (b *builder) Where(column string, operator string, values ...any) QueryBuilder {
@@ -16,6 +16,8 @@ func (b *builder) OrWhere(column string, operator string, values ...any) QueryBu
 }
 
 func (b *builder) addWhere(conj, column, operator string, values ...any) {
+	operator = normalizeOperator(operator)
+
 	switch operator {
 	case "IN", "NOT IN":
 		b.addWhereIn(conj, column, operator, values...)
@@ -40,6 +42,28 @@ func (b *builder) addWhere(conj, column, operator string, values ...any) {
 		b.addWhereNull(conj, column, operator)
 
 	default:
+		if !b.supportsOperator(operator) {
+			b.addErr(ErrInvalidOperator)
+			return
+		}
+
+		if len(values) == 1 {
+			switch v := values[0].(type) {
+			case ColumnRef:
+				b.addWhereColumn(conj, column, operator, v)
+				return
+
+			case Expression:
+				b.addWhereExpr(conj, column, operator, v)
+				return
+			}
+		}
+
+		if !b.validColumn(column) {
+			b.addErr(ErrInvalidIdentifier)
+			return
+		}
+
 		if values == nil {
 			values = []any{}
 		}
@@ -54,6 +78,57 @@ func (b *builder) addWhere(conj, column, operator string, values ...any) {
 	}
 }
 
+func (b *builder) addWhereColumn(conj, column, operator string, value ColumnRef) {
+	if column == "" || value.name == "" {
+		b.addErr(ErrEmptyColumn)
+		return
+	}
+
+	if !b.validColumn(column) || !b.validColumn(value.name) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	b.wheres = append(b.wheres, where{
+		queryType: QueryColumn,
+		conj:      conj,
+		column:    column,
+		operator:  operator,
+		expr:      value.name,
+	})
+}
+
+func (b *builder) addWhereExpr(conj, column, operator string, value Expression) {
+	if column == "" {
+		b.addErr(ErrEmptyColumn)
+		return
+	}
+
+	if !b.validColumn(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	if value.expr == "" {
+		b.addErr(ErrEmptyExpression)
+		return
+	}
+
+	if err := b.checkRawArgs(value.expr, value.args); err != nil {
+		b.addErr(err)
+		return
+	}
+
+	b.wheres = append(b.wheres, where{
+		queryType: QueryExpr,
+		conj:      conj,
+		column:    column,
+		operator:  operator,
+		expr:      value.expr,
+		args:      value.args,
+	})
+}
+
 func (b *builder) WhereBetween(column string, from, to any) QueryBuilder {
 	b.addWhereBetween("AND", column, "BETWEEN", from, to)
 	return b
@@ -80,11 +155,21 @@ func (b *builder) addWhereBetween(conj, column, operator string, from, to any) {
 		return
 	}
 
+	if !b.validColumn(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
 	if from == nil || to == nil {
 		b.addErr(ErrNilNotAllowed)
 		return
 	}
 
+	if isWrapped(from) || isWrapped(to) {
+		b.addErr(ErrTypeMismatch)
+		return
+	}
+
 	b.wheres = append(b.wheres, where{
 		queryType: QueryBetween,
 		conj:      conj,
@@ -120,12 +205,22 @@ func (b *builder) addWhereIn(conj, column, operator string, values ...any) {
 		return
 	}
 
+	if !b.validColumn(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
 	args, err := flattenArgs(values)
 	if err != nil {
 		b.addErr(err)
 		return
 	}
 
+	if slices.ContainsFunc(args, isWrapped) {
+		b.addErr(ErrTypeMismatch)
+		return
+	}
+
 	b.wheres = append(b.wheres, where{
 		queryType: QueryIn,
 		conj:      conj,
@@ -161,6 +256,11 @@ func (b *builder) addWhereNull(conj, column, operator string) {
 		return
 	}
 
+	if !b.validColumn(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
 	b.wheres = append(b.wheres, where{
 		queryType: QueryNull,
 		conj:      conj,
@@ -186,6 +286,11 @@ func (b *builder) addWhereRaw(conj, expr string, args ...any) {
 		return
 	}
 
+	if err := b.checkRawArgs(expr, args); err != nil {
+		b.addErr(err)
+		return
+	}
+
 	b.wheres = append(b.wheres, where{
 		queryType: QueryRaw,
 		conj:      conj,
@@ -211,6 +316,7 @@ func (b *builder) addWhereGroup(conj string, fn func(QueryBuilder)) {
 	}
 
 	nestedBuilder := New(b.dialect).(*builder)
+	nestedBuilder.inHaving = b.inHaving
 	fn(nestedBuilder)
 
 	// propagate child error
@@ -264,6 +370,19 @@ func (b *builder) addWhereSub(conj, column, operator string, fn func(QueryBuilde
 		return
 	}
 
+	operator = normalizeOperator(operator)
+
+	// EXISTS / NOT EXISTS are set by the builder and take no column
+	if column != "" && !b.supportsSubOperator(operator) {
+		b.addErr(ErrInvalidOperator)
+		return
+	}
+
+	if column != "" && !b.validColumn(column) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
 	subBuilder := New(b.dialect).(*builder)
 	subBuilder.action = "select"
 	fn(subBuilder)
@@ -278,7 +397,7 @@ func (b *builder) addWhereSub(conj, column, operator string, fn func(QueryBuilde
 		queryType: QuerySub,
 		conj:      conj,
 		column:    column,
-		operator:  strings.ToUpper(operator),
+		operator:  operator,
 		sub:       subBuilder,
 	})
 }
@@ -314,6 +314,89 @@ func TestBuilder_Where(t *testing.T) {
 			values:        []any{[]int{18}},
 			expectedError: ErrNilNotAllowed,
 		},
+
+		// -------------------------------------------
+		// ------------ Where Column / Raw ------------
+		// -------------------------------------------
+		{
+			name:          "should add a column to column comparison",
+			initialWheres: []where{},
+			column:        "updated_at",
+			operator:      ">",
+			values:        []any{Col("created_at")},
+			expectedWheres: []where{
+				{queryType: QueryColumn, conj: "AND", column: "updated_at", operator: ">", expr: "created_at"},
+			},
+		},
+		{
+			name:          "should add a raw expression comparison with args",
+			initialWheres: []where{},
+			column:        "price",
+			operator:      ">",
+			values:        []any{Raw("cost * ?", 1.2)},
+			expectedWheres: []where{
+				{queryType: QueryExpr, conj: "AND", column: "price", operator: ">", expr: "cost * ?", args: []any{1.2}},
+			},
+		},
+		{
+			name:          "should return an error for an empty column value",
+			column:        "updated_at",
+			operator:      ">",
+			values:        []any{Col("")},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name:          "should return an error for an empty raw value",
+			column:        "created_at",
+			operator:      "<",
+			values:        []any{Raw("")},
+			expectedError: ErrEmptyExpression,
+		},
+		{
+			name:          "should return an error when raw value args do not match",
+			column:        "price",
+			operator:      ">",
+			values:        []any{Raw("cost * ?")},
+			expectedError: ErrArgCountMismatch,
+		},
+
+		// -------------------------------------------
+		// -------------- Where Operator --------------
+		// -------------------------------------------
+		{
+			name:          "should normalize operator case and whitespace",
+			initialWheres: []where{},
+			column:        "name",
+			operator:      " like ",
+			values:        []any{"%john%"},
+			expectedWheres: []where{
+				{queryType: QueryBasic, conj: "AND", column: "name", operator: "LIKE", args: []any{"%john%"}},
+			},
+		},
+		{
+			name:          "should route a lowercase in operator",
+			initialWheres: []where{},
+			column:        "id",
+			operator:      "not in",
+			values:        []any{1, 2},
+			expectedWheres: []where{
+				{queryType: QueryIn, conj: "AND", column: "id", operator: "NOT IN", args: []any{1, 2}},
+			},
+		},
+		{
+			name:          "should return an error for an invalid operator",
+			column:        "id",
+			operator:      "= 1 OR 1 =",
+			values:        []any{1},
+			expectedError: ErrInvalidOperator,
+		},
+		{
+			name:          "should return an error for an empty operator",
+			column:        "id",
+			operator:      "",
+			values:        []any{1},
+			expectedError: ErrInvalidOperator,
+		},
 	}
 
 	for _, tt := range tests {
@@ -732,6 +815,22 @@ func TestBuilder_WhereBetween(t *testing.T) {
 			expectedWheres: nil,
 			expectedError:  ErrNilNotAllowed,
 		},
+		{
+			name:           "should return an error for BETWEEN with a column reference",
+			column:         "age",
+			from:           Col("min_age"),
+			to:             30,
+			expectedWheres: nil,
+			expectedError:  ErrTypeMismatch,
+		},
+		{
+			name:           "should return an error for BETWEEN with a raw expression",
+			column:         "created_at",
+			from:           "2023-01-01",
+			to:             Raw("NOW()"),
+			expectedWheres: nil,
+			expectedError:  ErrTypeMismatch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1125,6 +1224,18 @@ func TestBuilder_WhereIn(t *testing.T) {
 			values:        []any{[]any{[]int{1, 2}}, []int{3, 4}},
 			expectedError: ErrNestedSlice,
 		},
+		{
+			name:          "should return an error for IN with a column reference",
+			column:        "id",
+			values:        []any{1, Col("parent_id")},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:          "should return an error for IN with a raw expression",
+			column:        "id",
+			values:        []any{Raw("SELECT 1")},
+			expectedError: ErrTypeMismatch,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1775,6 +1886,31 @@ func TestBuilder_WhereRaw(t *testing.T) {
 			expectedWheres: []where{},
 			expectedError:  ErrEmptyExpression,
 		},
+		{
+			name:           "should return an error when args outnumber the placeholders",
+			initialWheres:  []where{},
+			expression:     "id = ?",
+			args:           []any{1, 2},
+			expectedWheres: []where{},
+			expectedError:  ErrArgCountMismatch,
+		},
+		{
+			name:           "should return an error when placeholders outnumber the args",
+			initialWheres:  []where{},
+			expression:     "id = ? AND name = ?",
+			args:           []any{1},
+			expectedWheres: []where{},
+			expectedError:  ErrArgCountMismatch,
+		},
+		{
+			name:          "should not count quoted and escaped question marks",
+			initialWheres: []where{},
+			expression:    "note <> 'why?' AND data ?? 'key' AND id = ?",
+			args:          []any{1},
+			expectedWheres: []where{
+				{queryType: QueryRaw, conj: "AND", expr: "note <> 'why?' AND data ?? 'key' AND id = ?", args: []any{1}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2219,6 +2355,35 @@ func TestBuilder_WhereSub(t *testing.T) {
 			expectedWheres: []where{},
 			expectedError:  ErrEmptyTable,
 		},
+		{
+			name:          "should normalize a quantified comparison operator",
+			initialWheres: []where{},
+			column:        "price",
+			operator:      "> all",
+			subFn: func(qb QueryBuilder) {
+				qb.Select("price").From("products")
+			},
+			expectedWheres: []where{
+				{
+					queryType: QuerySub,
+					conj:      "AND",
+					column:    "price",
+					operator:  "> ALL",
+					sub:       New(PostgresDialect{}).Select("price").From("products"),
+				},
+			},
+		},
+		{
+			name:          "should return an error for an invalid operator",
+			initialWheres: []where{},
+			column:        "user_id",
+			operator:      "IN (SELECT 1) OR 1 =",
+			subFn: func(qb QueryBuilder) {
+				qb.Select("id").From("users")
+			},
+			expectedWheres: []where{},
+			expectedError:  ErrInvalidOperator,
+		},
 	}
 
 	for _, tt := range tests {
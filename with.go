@@ -0,0 +1,78 @@
+package sequel
+
+func (b *builder) With(name string, fn func(QueryBuilder)) QueryBuilder {
+	b.addCTE(name, nil, false, "", fn)
+	return b
+}
+
+func (b *builder) WithColumns(name string, columns []string, fn func(QueryBuilder)) QueryBuilder {
+	b.addCTE(name, columns, false, "", fn)
+	return b
+}
+
+func (b *builder) WithRecursive(name string, columns []string, fn func(QueryBuilder)) QueryBuilder {
+	b.addCTE(name, columns, true, "", fn)
+	return b
+}
+
+func (b *builder) WithMaterialized(name string, fn func(QueryBuilder)) QueryBuilder {
+	b.addCTE(name, nil, false, "MATERIALIZED", fn)
+	return b
+}
+
+func (b *builder) WithNotMaterialized(name string, fn func(QueryBuilder)) QueryBuilder {
+	b.addCTE(name, nil, false, "NOT MATERIALIZED", fn)
+	return b
+}
+
+func (b *builder) addCTE(name string, columns []string, recursive bool, materialized string, fn func(QueryBuilder)) {
+	if name == "" {
+		b.addErr(ErrEmptyAlias)
+		return
+	}
+
+	if !b.validAlias(name) {
+		b.addErr(ErrInvalidIdentifier)
+		return
+	}
+
+	for _, col := range columns {
+		if col == "" {
+			b.addErr(ErrEmptyColumn)
+			return
+		}
+
+		if !b.validAlias(col) {
+			b.addErr(ErrInvalidIdentifier)
+			return
+		}
+	}
+
+	if fn == nil {
+		b.addErr(ErrNilFunc)
+		return
+	}
+
+	if materialized != "" && b.dialect != nil && !b.dialect.Capabilities().SupportsMaterializedCTE {
+		b.addErr(unsupportedFeature(materialized))
+		return
+	}
+
+	subBuilder := New(b.dialect).(*builder)
+	subBuilder.action = "select"
+	fn(subBuilder)
+
+	// propagate child error
+	if subBuilder.err != nil {
+		b.addErr(subBuilder.err)
+		return
+	}
+
+	b.ctes = append(b.ctes, cte{
+		name:         name,
+		columns:      columns,
+		recursive:    recursive,
+		materialized: materialized,
+		sub:          subBuilder,
+	})
+}
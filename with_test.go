@@ -0,0 +1,146 @@
+package sequel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_With(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		dialect              Dialect
+		build                func(*builder) QueryBuilder
+		expectedName         string
+		expectedColumns      []string
+		expectedRecursive    bool
+		expectedMaterialized string
+		expectedError        error
+	}{
+		{
+			name: "should add a CTE",
+			build: func(b *builder) QueryBuilder {
+				return b.With("active_users", func(q QueryBuilder) {
+					q.Select("id").From("users").Where("active", "=", true)
+				})
+			},
+			expectedName: "active_users",
+		},
+		{
+			name: "should add a CTE with column list",
+			build: func(b *builder) QueryBuilder {
+				return b.WithColumns("totals", []string{"user_id", "total"}, func(q QueryBuilder) {
+					q.Select("user_id").AddSelectRaw("SUM(amount)").From("orders").GroupBy("user_id")
+				})
+			},
+			expectedName:    "totals",
+			expectedColumns: []string{"user_id", "total"},
+		},
+		{
+			name: "should add a recursive CTE",
+			build: func(b *builder) QueryBuilder {
+				return b.WithRecursive("tree", []string{"id", "parent_id"}, func(q QueryBuilder) {
+					q.Select("id", "parent_id").From("categories").WhereNull("parent_id")
+				})
+			},
+			expectedName:      "tree",
+			expectedColumns:   []string{"id", "parent_id"},
+			expectedRecursive: true,
+		},
+		{
+			name: "should add a MATERIALIZED CTE",
+			build: func(b *builder) QueryBuilder {
+				return b.WithMaterialized("recent", func(q QueryBuilder) {
+					q.Select("id").From("orders")
+				})
+			},
+			expectedName:         "recent",
+			expectedMaterialized: "MATERIALIZED",
+		},
+		{
+			name: "should add a NOT MATERIALIZED CTE",
+			build: func(b *builder) QueryBuilder {
+				return b.WithNotMaterialized("recent", func(q QueryBuilder) {
+					q.Select("id").From("orders")
+				})
+			},
+			expectedName:         "recent",
+			expectedMaterialized: "NOT MATERIALIZED",
+		},
+		{
+			name: "should return error when name is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.With("", func(q QueryBuilder) {
+					q.Select("id").From("users")
+				})
+			},
+			expectedError: ErrEmptyAlias,
+		},
+		{
+			name: "should return error when a column is empty",
+			build: func(b *builder) QueryBuilder {
+				return b.WithColumns("totals", []string{"user_id", ""}, func(q QueryBuilder) {
+					q.Select("user_id").From("orders")
+				})
+			},
+			expectedError: ErrEmptyColumn,
+		},
+		{
+			name: "should return error when function is nil",
+			build: func(b *builder) QueryBuilder {
+				return b.WithRecursive("tree", nil, nil)
+			},
+			expectedError: ErrNilFunc,
+		},
+		{
+			name:    "should return error when dialect does not support materialization hints",
+			dialect: limitedDialect{},
+			build: func(b *builder) QueryBuilder {
+				return b.WithMaterialized("recent", func(q QueryBuilder) {
+					q.Select("id").From("orders")
+				})
+			},
+			expectedError: ErrUnsupportedFeature,
+		},
+		{
+			name: "should propagate error from CTE body",
+			build: func(b *builder) QueryBuilder {
+				return b.With("broken", func(q QueryBuilder) {
+					q.Select("id").From("")
+				})
+			},
+			expectedError: ErrEmptyTable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := &builder{dialect: tt.dialect}
+
+			// Act
+			result := tt.build(b)
+
+			// Assert
+			assert.Equal(t, b, result, "expected method to return the same builder instance")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, b.err, tt.expectedError, "expected error to match")
+				assert.Empty(t, b.ctes, "expected no CTE to be added on error")
+				return
+			}
+
+			assert.NoError(t, b.err, "expected no error")
+			assert.Len(t, b.ctes, 1, "expected a single CTE")
+			assert.Equal(t, tt.expectedName, b.ctes[0].name, "expected CTE name to match")
+			assert.Equal(t, tt.expectedColumns, b.ctes[0].columns, "expected CTE columns to match")
+			assert.Equal(t, tt.expectedRecursive, b.ctes[0].recursive, "expected CTE recursion to match")
+			assert.Equal(t, tt.expectedMaterialized, b.ctes[0].materialized, "expected CTE materialization to match")
+			assert.Equal(t, "select", b.ctes[0].sub.(*builder).action, "expected CTE body to be a select")
+		})
+	}
+}